@@ -2,10 +2,24 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/ai/external"
+	_ "github.com/BrandonDedolph/euchre/internal/ai/mcts"       // registers the ISMCTS strategy
+	_ "github.com/BrandonDedolph/euchre/internal/ai/rule_based" // registers the rule-based strategy
 	"github.com/BrandonDedolph/euchre/internal/app"
-	_ "github.com/BrandonDedolph/euchre/internal/variants/standard" // Register standard variant
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/sim"
+	"github.com/BrandonDedolph/euchre/internal/tournament"
+	"github.com/BrandonDedolph/euchre/internal/variants"
+	_ "github.com/BrandonDedolph/euchre/internal/variants/british"   // registers British Euchre
+	_ "github.com/BrandonDedolph/euchre/internal/variants/cutthroat" // registers three-handed Cutthroat
+	_ "github.com/BrandonDedolph/euchre/internal/variants/sixhanded" // registers six-handed Euchre
+	_ "github.com/BrandonDedolph/euchre/internal/variants/standard"  // registers the standard variant
+	_ "github.com/BrandonDedolph/euchre/internal/variants/twohanded" // registers two-handed Euchre
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/urfave/cli/v2"
 )
@@ -20,6 +34,8 @@ func main() {
 		Usage:   "Learn and play the classic Euchre card game",
 		Version: version,
 		Action:  runTUI,
+		// --bot commands may contain commas
+		DisableSliceFlagSeparator: true,
 		Commands: []*cli.Command{
 			{
 				Name:    "rules",
@@ -48,6 +64,143 @@ func main() {
 				Name:   "play",
 				Usage:  "Start a game immediately",
 				Action: runTUI,
+				Flags: []cli.Flag{
+					&cli.Int64Flag{
+						Name:  "seed",
+						Usage: "deal every hand from this seed to replay an exact game (shown on the in-game ? sheet)",
+					},
+					&cli.StringFlag{
+						Name:  "strategy",
+						Usage: "AI strategy for the opponents: " + strings.Join(ai.Strategies(), ", ") + " or a --bot",
+					},
+					&cli.StringSliceFlag{
+						Name:  "bot",
+						Usage: "add an external bot as strategy NAME (NAME=COMMAND, repeatable)",
+					},
+				},
+			},
+			{
+				Name:   "simulate",
+				Usage:  "Play AI-vs-AI games headlessly and print aggregate results",
+				Action: runSimulate,
+				Flags: []cli.Flag{
+					&cli.IntFlag{
+						Name:    "games",
+						Aliases: []string{"n"},
+						Usage:   "number of games to play (boards with --duplicate)",
+						Value:   1000,
+					},
+					&cli.BoolFlag{
+						Name:  "duplicate",
+						Usage: "play single-hand boards at two tables with the teams' cards swapped and compare them deal by deal",
+					},
+					&cli.BoolFlag{
+						Name:  "explain",
+						Usage: "print every deal and each AI decision with its reasons (try it with --games 1)",
+					},
+					&cli.Int64Flag{
+						Name:  "seed",
+						Usage: "master seed for every deal (0 picks a random seed)",
+					},
+					&cli.StringFlag{
+						Name:  "team0",
+						Usage: "difficulty of team 0 (seats 0 and 2): easy, medium, hard or expert",
+						Value: "medium",
+					},
+					&cli.StringFlag{
+						Name:  "team1",
+						Usage: "difficulty of team 1 (seats 1 and 3): easy, medium, hard or expert",
+						Value: "medium",
+					},
+					&cli.StringFlag{
+						Name:  "team0-strategy",
+						Usage: "AI strategy of team 0: " + strings.Join(ai.Strategies(), ", ") + " or a --bot",
+						Value: ai.DefaultStrategy,
+					},
+					&cli.StringFlag{
+						Name:  "team1-strategy",
+						Usage: "AI strategy of team 1: " + strings.Join(ai.Strategies(), ", ") + " or a --bot",
+						Value: ai.DefaultStrategy,
+					},
+					&cli.StringSliceFlag{
+						Name:  "bot",
+						Usage: "add an external bot as strategy NAME (NAME=COMMAND, repeatable)",
+					},
+					&cli.BoolFlag{
+						Name:  "stick-the-dealer",
+						Usage: "dealer must call trump if everyone passes",
+					},
+					&cli.BoolFlag{
+						Name:  "defend-alone",
+						Usage: "allow defenders to go alone against a lone maker",
+					},
+					&cli.BoolFlag{
+						Name:  "sevens-and-eights",
+						Usage: "play with the 7s and 8s, a 32-card deck (where the variant allows it)",
+					},
+					&cli.StringFlag{
+						Name:  "variant",
+						Usage: "rules variant to play: " + strings.Join(variants.List(), ", "),
+						Value: "Standard",
+					},
+				},
+			},
+			{
+				Name:  "tournament",
+				Usage: "Rate AI configurations in a round-robin of duplicate matches",
+				Description: "Every pair of entries plays the same seeded boards twice, swapping\n" +
+					"seats, and the results are fitted to Elo ratings with 95% confidence\n" +
+					"intervals. An entry is [NAME=]STRATEGY[:DIFFICULTY], for example\n" +
+					"--entry mcts:hard --entry base=rule-based:medium --entry mybot\n" +
+					"--bot \"mybot=python3 mybot.py\".",
+				Action: runTournament,
+				Flags: []cli.Flag{
+					&cli.StringSliceFlag{
+						Name:     "entry",
+						Aliases:  []string{"e"},
+						Usage:    "AI configuration to enter: [NAME=]STRATEGY[:DIFFICULTY] (repeatable, at least 2)",
+						Required: true,
+					},
+					&cli.IntFlag{
+						Name:    "boards",
+						Aliases: []string{"n"},
+						Usage:   "seeded boards per pairing; each is played in both seatings",
+						Value:   100,
+					},
+					&cli.Int64Flag{
+						Name:  "seed",
+						Usage: "master seed for every deal (0 picks a random seed)",
+					},
+					&cli.StringSliceFlag{
+						Name:  "bot",
+						Usage: "add an external bot as strategy NAME (NAME=COMMAND, repeatable)",
+					},
+					&cli.BoolFlag{
+						Name:  "stick-the-dealer",
+						Usage: "dealer must call trump if everyone passes",
+					},
+					&cli.BoolFlag{
+						Name:  "defend-alone",
+						Usage: "allow defenders to go alone against a lone maker",
+					},
+					&cli.BoolFlag{
+						Name:  "sevens-and-eights",
+						Usage: "play with the 7s and 8s, a 32-card deck (where the variant allows it)",
+					},
+					&cli.StringFlag{
+						Name:  "variant",
+						Usage: "rules variant to play: " + strings.Join(variants.List(), ", "),
+						Value: "Standard",
+					},
+				},
+			},
+			{
+				Name:      "replay",
+				Usage:     "Step through recorded hands with every card face-up",
+				ArgsUsage: "[FILE]",
+				Description: "Opens the replay viewer on a JSON file of hand histories, or on the\n" +
+					"hands of the last game played when no file is given.",
+				Action: runReplay,
 			},
 		},
 	}
@@ -60,11 +213,276 @@ func main() {
 
 // runTUI starts the TUI application
 func runTUI(c *cli.Context) error {
-	p := tea.NewProgram(app.New(), tea.WithAltScreen())
+	// The TUI owns the terminal, so bots' stderr is dropped.
+	if err := registerBots(c.StringSlice("bot"), nil); err != nil {
+		return err
+	}
+	strategy := c.String("strategy")
+	if _, ok := ai.DefaultRegistry.Get(strategy); strategy != "" && !ok {
+		return fmt.Errorf("unknown AI strategy %q (want %s)", strategy, strings.Join(ai.Strategies(), ", "))
+	}
+	p := tea.NewProgram(app.NewWithOptions(c.Int64("seed"), strategy), tea.WithAltScreen())
 	_, err := p.Run()
 	return err
 }
 
+// runReplay opens the TUI straight into the replay viewer.
+func runReplay(c *cli.Context) error {
+	var hands []engine.HandHistory
+	var err error
+	if path := c.Args().First(); path != "" {
+		hands, err = app.LoadHandHistories(path)
+	} else {
+		hands, err = app.LoadLastHandHistories()
+	}
+	if err != nil {
+		return fmt.Errorf("loading hands: %w", err)
+	}
+	p := tea.NewProgram(app.NewWithReplay(hands), tea.WithAltScreen())
+	_, err = p.Run()
+	return err
+}
+
+// runSimulate plays a batch of AI-vs-AI games with no TUI and prints the
+// aggregate results per team.
+func runSimulate(c *cli.Context) error {
+	team0, err := ai.ParseDifficulty(c.String("team0"))
+	if err != nil {
+		return err
+	}
+	team1, err := ai.ParseDifficulty(c.String("team1"))
+	if err != nil {
+		return err
+	}
+	if c.Int("games") <= 0 {
+		return fmt.Errorf("--games must be positive")
+	}
+	if err := registerBots(c.StringSlice("bot"), c.App.ErrWriter); err != nil {
+		return err
+	}
+
+	gameConfig, err := gameConfigFromFlags(c)
+	if err != nil {
+		return err
+	}
+
+	difficulties := [2]ai.Difficulty{team0, team1}
+	strategies := [2]string{c.String("team0-strategy"), c.String("team1-strategy")}
+	var labels [2]string
+	for team := range labels {
+		labels[team] = difficulties[team].String()
+		if strategies[team] != ai.DefaultStrategy {
+			labels[team] += " " + strategies[team]
+		}
+	}
+	if c.Bool("duplicate") {
+		if c.Bool("explain") {
+			return fmt.Errorf("--explain can't be combined with --duplicate")
+		}
+		return runDuplicate(c, gameConfig, strategies, difficulties, labels)
+	}
+
+	// At tables of more than two teams, the team 0 settings play for every
+	// even-numbered team and the team 1 settings for every odd one.
+	players := make([]ai.Player, gameConfig.NumPlayers)
+	for i := range players {
+		team := engine.TeamOf(i, gameConfig.Rules.TeamCount()) % 2
+		if players[i], err = ai.NewPlayer(strategies[team], ai.PlayerNames[i], i, difficulties[team]); err != nil {
+			ai.ClosePlayers(players[:i])
+			return err
+		}
+	}
+	defer ai.ClosePlayers(players)
+
+	simConfig := sim.Config{
+		Games: c.Int("games"),
+		Seed:  c.Int64("seed"),
+		Game:  gameConfig,
+	}
+	if c.Bool("explain") {
+		simConfig.Explain = c.App.Writer
+	}
+	res, err := sim.Run(simConfig, players)
+	if err != nil {
+		return err
+	}
+
+	printSimResults(c.App.Writer, res, labels)
+	for _, p := range players {
+		if bot, ok := p.(*external.Player); ok && bot.Err() != nil {
+			fmt.Fprintf(c.App.ErrWriter, "Warning: %v; it made the first legal move from then on\n", bot.Err())
+		}
+	}
+	return nil
+}
+
+// gameConfigFromFlags builds the game configuration for headless runs from
+// the chosen variant and the rule flags.
+func gameConfigFromFlags(c *cli.Context) (engine.GameConfig, error) {
+	v, ok := variants.New(c.String("variant"))
+	if !ok {
+		return engine.GameConfig{}, fmt.Errorf("unknown variant %q (want %s)", c.String("variant"), strings.Join(variants.List(), ", "))
+	}
+	_ = v.SetOption("stick_the_dealer", c.Bool("stick-the-dealer"))
+	_ = v.SetOption("defend_alone", c.Bool("defend-alone"))
+	if c.Bool("sevens-and-eights") {
+		if !variants.Offers(v, "sevens_and_eights") {
+			return engine.GameConfig{}, fmt.Errorf("the %s variant can't be played with 7s and 8s", v.Name())
+		}
+		_ = v.SetOption("sevens_and_eights", true)
+	}
+	return variants.EngineConfig(v), nil
+}
+
+// runTournament plays a round-robin tournament between AI entries and
+// prints their ratings and head-to-head results.
+func runTournament(c *cli.Context) error {
+	if err := registerBots(c.StringSlice("bot"), c.App.ErrWriter); err != nil {
+		return err
+	}
+	var entries []tournament.Entry
+	for _, spec := range c.StringSlice("entry") {
+		e, err := tournament.ParseEntry(spec)
+		if err != nil {
+			return err
+		}
+		if _, ok := ai.DefaultRegistry.Get(e.Strategy); !ok {
+			return fmt.Errorf("entry %q: unknown AI strategy %q (want %s or a --bot)", spec, e.Strategy, strings.Join(ai.Strategies(), ", "))
+		}
+		entries = append(entries, e)
+	}
+
+	gameConfig, err := gameConfigFromFlags(c)
+	if err != nil {
+		return err
+	}
+	res, err := tournament.Run(tournament.Config{
+		Entries: entries,
+		Boards:  c.Int("boards"),
+		Seed:    c.Int64("seed"),
+		Game:    gameConfig,
+		Progress: func(p tournament.Pairing) {
+			fmt.Fprintf(c.App.ErrWriter, "%s vs %s: %d-%d (boards %d won, %d split, %d lost)\n",
+				entries[p.A].Name, entries[p.B].Name, p.Wins[0], p.Wins[1], p.Boards[2], p.Boards[1], p.Boards[0])
+		},
+	})
+	if err != nil {
+		return err
+	}
+	printTournament(c.App.Writer, res, c.Int("boards"))
+	return nil
+}
+
+// printTournament writes the rating table and the head-to-head win/loss
+// table of a tournament.
+func printTournament(w io.Writer, res tournament.Results, boards int) {
+	fmt.Fprintf(w, "\nEntries: %d   Boards per pairing: %d (%d games)   Seed: %d\n\n", len(res.Entries), boards, 2*boards, res.Seed)
+	fmt.Fprintf(w, "%4s  %-20s %6s  %-14s %7s %7s\n", "Rank", "Entry", "Elo", "95% CI", "Games", "Win%")
+	for rank, r := range res.Ratings() {
+		fmt.Fprintf(w, "%4d  %-20s %6.0f  %-14s %7d %6.1f%%\n",
+			rank+1,
+			res.Entries[r.Entry].Name,
+			r.Elo,
+			fmt.Sprintf("[%.0f, %.0f]", r.Low, r.High),
+			r.Games,
+			100*r.WinRate(),
+		)
+	}
+
+	fmt.Fprintf(w, "\nGames won-lost by row against column\n%-20s", "")
+	for _, e := range res.Entries {
+		fmt.Fprintf(w, " %12s", truncate(e.Name, 12))
+	}
+	fmt.Fprintln(w)
+	for a, e := range res.Entries {
+		fmt.Fprintf(w, "%-20s", truncate(e.Name, 20))
+		for b := range res.Entries {
+			cell := "-"
+			if p, ok := res.Pairing(a, b); ok {
+				cell = fmt.Sprintf("%d-%d", p.Wins[0], p.Wins[1])
+			}
+			fmt.Fprintf(w, " %12s", cell)
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// truncate shortens s to at most n runes
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
+
+// registerBots adds each NAME=COMMAND spec as an external bot strategy. The
+// command is split on spaces, and the bots' standard error goes to stderr.
+func registerBots(specs []string, stderr io.Writer) error {
+	for _, spec := range specs {
+		name, command, ok := strings.Cut(spec, "=")
+		args := strings.Fields(command)
+		if !ok || name == "" || len(args) == 0 {
+			return fmt.Errorf("--bot %q: want NAME=COMMAND", spec)
+		}
+		external.Register(name, external.Config{Command: args, Stderr: stderr})
+	}
+	return nil
+}
+
+// runDuplicate plays the simulate command's boards in duplicate: each side
+// plays every board once with each team's cards.
+func runDuplicate(c *cli.Context, gameConfig engine.GameConfig, strategies [2]string, difficulties [2]ai.Difficulty, labels [2]string) error {
+	var sides [2][]ai.Player
+	defer func() {
+		ai.ClosePlayers(sides[0])
+		ai.ClosePlayers(sides[1])
+	}()
+	for side := range sides {
+		for i := 0; i < gameConfig.NumPlayers; i++ {
+			p, err := ai.NewPlayer(strategies[side], ai.PlayerNames[i], i, difficulties[side])
+			if err != nil {
+				return err
+			}
+			sides[side] = append(sides[side], p)
+		}
+	}
+
+	res, err := sim.RunDuplicate(sim.DuplicateConfig{
+		Boards: c.Int("games"),
+		Seed:   c.Int64("seed"),
+		Game:   gameConfig,
+	}, sides)
+	if err != nil {
+		return err
+	}
+
+	won, tied, lost := res.Record()
+	w := c.App.Writer
+	fmt.Fprintf(w, "Boards: %d   Seed: %d\n\n", len(res.Boards), res.Seed)
+	fmt.Fprintf(w, "Side 0 (%s) vs side 1 (%s)\n", labels[0], labels[1])
+	fmt.Fprintf(w, "Net points: %+d (%+.3f per board)\n", res.Net(), float64(res.Net())/float64(len(res.Boards)))
+	fmt.Fprintf(w, "Boards won / tied / lost by side 0: %d / %d / %d\n", won, tied, lost)
+	return nil
+}
+
+// printSimResults writes a per-team summary table of a simulation batch.
+func printSimResults(w io.Writer, res sim.Results, labels [2]string) {
+	fmt.Fprintf(w, "Games: %d   Hands: %d   Misdeals: %d   Seed: %d\n\n", res.Games, res.Hands, res.Misdeals, res.Seed)
+	fmt.Fprintf(w, "%-20s %8s %8s %8s %8s %8s %8s\n", "Team", "Win%", "Calls", "Euchre%", "Loners", "Loner%", "Pts/Hand")
+	for team := 0; team < res.Teams(); team++ {
+		label := fmt.Sprintf("Team %d (%s)", team, labels[team%2])
+		fmt.Fprintf(w, "%-20s %7.1f%% %8d %7.1f%% %8d %7.1f%% %8.3f\n",
+			label,
+			100*res.WinRate(team),
+			res.Calls[team],
+			100*res.EuchreRate(team),
+			res.LonerAttempts[team],
+			100*res.LonerSuccessRate(team),
+			res.PointsPerHand(team),
+		)
+	}
+}
+
 // showRules displays general Euchre rules
 func showRules(c *cli.Context) error {
 	fmt.Print(`
@@ -77,6 +495,8 @@ Partners sit across from each other.
 THE DECK
 --------
 24 cards: 9, 10, J, Q, K, A of each suit (Clubs, Diamonds, Hearts, Spades)
+Some tables add the 7s and 8s for a 32-card deck. They rank below the 9,
+hands are still 5 cards, and the extra cards stay in the kitty.
 
 OBJECTIVE
 ---------
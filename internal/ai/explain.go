@@ -0,0 +1,79 @@
+package ai
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// Explanation is the rationale behind one AI decision: the numbers it was
+// made on and the rule that picked it.
+type Explanation struct {
+	Decision   string        // what was decided, e.g. "pass", "order up alone", "play J♥"
+	Trump      engine.Suit   // suit the hand was judged for (NoSuit when none)
+	Strength   int           // hand strength (0-100) for bids
+	Threshold  int           // strength needed to bid; 0 when the decision wasn't scored
+	Candidates []engine.Card // the cards chosen between, for plays and discards
+	Card       engine.Card   // the card played or discarded
+	Reason     string        // why, in a few words
+}
+
+// Scored reports whether the decision was made on a hand strength score
+func (e Explanation) Scored() bool {
+	return e.Threshold > 0
+}
+
+// String formats the explanation on one line, e.g.
+// "order up (♥ Hearts: strength 62, needs 55): strong enough to order up"
+func (e Explanation) String() string {
+	var b strings.Builder
+	b.WriteString(e.Decision)
+	switch {
+	case e.Scored() && e.Trump != engine.NoSuit:
+		fmt.Fprintf(&b, " (%s %s: strength %d, needs %d)", e.Trump.Symbol(), e.Trump, e.Strength, e.Threshold)
+	case e.Scored():
+		fmt.Fprintf(&b, " (strength %d, needs %d)", e.Strength, e.Threshold)
+	case len(e.Candidates) > 1:
+		cards := make([]string, len(e.Candidates))
+		for i, c := range e.Candidates {
+			cards[i] = c.String()
+		}
+		fmt.Fprintf(&b, " from %s", strings.Join(cards, " "))
+	}
+	if e.Reason != "" {
+		b.WriteString(": " + e.Reason)
+	}
+	return b.String()
+}
+
+// DescribeBid names a bidding decision, e.g. "pass", "order up alone" or
+// "call Hearts"
+func DescribeBid(d engine.BidDecision) string {
+	var s string
+	switch {
+	case d.Pass:
+		return "pass"
+	case d.OrderUp:
+		s = "order up"
+	default:
+		s = "call " + d.CallSuit.String()
+	}
+	if d.Alone {
+		s += " alone"
+	}
+	return s
+}
+
+// Explainer is implemented by players that can explain their decisions.
+// Explain returns the rationale for the player's most recent decision, or
+// false if it has made none.
+type Explainer interface {
+	Explain() (Explanation, bool)
+}
+
+// Reasoner is implemented by strategies that can say which rule chose the
+// card of their most recent SelectPlay, SelectLead or SelectDiscard.
+type Reasoner interface {
+	Reason() string
+}
@@ -0,0 +1,215 @@
+package external
+
+import (
+	"bufio"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/sim"
+)
+
+// botModeEnv makes the test binary act as a bot instead of running tests
+const botModeEnv = "EUCHRE_TEST_BOT"
+
+func TestMain(m *testing.M) {
+	switch os.Getenv(botModeEnv) {
+	case "":
+		os.Exit(m.Run())
+	case "eager":
+		// Orders up (alone) at the first chance and plays its last legal
+		// card. It also prints noise the game must skip.
+		os.Stdout.WriteString("thinking...\n")
+		Serve(os.Stdin, os.Stdout, "eager", func(req Request) engine.ActionRecord {
+			return req.Legal[len(req.Legal)-1]
+		})
+	case "illegal":
+		Serve(os.Stdin, os.Stdout, "illegal", func(req Request) engine.ActionRecord {
+			joker := engine.NewCard(engine.NoSuit, engine.Joker)
+			return engine.ActionRecord{Type: engine.ActionPlayCard, Card: &joker}
+		})
+	case "mute":
+		scanner := bufio.NewScanner(os.Stdin)
+		for scanner.Scan() {
+		}
+	}
+	os.Exit(0)
+}
+
+// startBot runs this test binary as a bot in the given mode
+func startBot(t *testing.T, mode string, seat int) (*Player, error) {
+	t.Helper()
+	t.Setenv(botModeEnv, mode)
+	return Start(ai.PlayerNames[seat], seat, Config{
+		Command: []string{os.Args[0], "-test.run=^$"},
+		Timeout: 2 * time.Second,
+	})
+}
+
+func TestBotsPlayACompleteGame(t *testing.T) {
+	players := make([]ai.Player, 4)
+	for i := range players {
+		p, err := startBot(t, "eager", i)
+		if err != nil {
+			t.Fatalf("starting bot for seat %d: %v", i, err)
+		}
+		defer p.Close()
+		players[i] = p
+	}
+
+	config := engine.DefaultGameConfig()
+	config.Seed = 7
+	game := engine.NewGame(config)
+	if _, err := sim.PlayGame(game, players); err != nil {
+		t.Fatalf("game between bots failed: %v", err)
+	}
+	if !game.IsOver() {
+		t.Fatal("game did not finish")
+	}
+	for i, p := range players {
+		bot := p.(*Player)
+		if err := bot.Err(); err != nil {
+			t.Errorf("seat %d: unexpected protocol error: %v", i, err)
+		}
+		if bot.BotName() != "eager" {
+			t.Errorf("seat %d: bot name = %q, want eager", i, bot.BotName())
+		}
+	}
+}
+
+func TestIllegalReplyFallsBackToALegalAction(t *testing.T) {
+	bot, err := startBot(t, "illegal", 1)
+	if err != nil {
+		t.Fatalf("starting bot: %v", err)
+	}
+	defer bot.Close()
+
+	config := engine.DefaultGameConfig()
+	config.Seed = 3
+	game := engine.NewGame(config)
+	game.StartRound()
+	for game.CurrentPlayer() != 1 {
+		if err := game.ApplyAction(engine.PassAction{PlayerIdx: game.CurrentPlayer()}); err != nil {
+			t.Fatalf("pass failed: %v", err)
+		}
+	}
+
+	action, err := sim.Decide(game, bot)
+	if err != nil {
+		t.Fatalf("Decide failed: %v", err)
+	}
+	if err := game.ApplyAction(action); err != nil {
+		t.Errorf("fallback action %s was not legal: %v", action.Type(), err)
+	}
+	if err := bot.Err(); err == nil || !strings.Contains(err.Error(), "illegal action") {
+		t.Errorf("Err() = %v, want an illegal action error", err)
+	}
+}
+
+func TestStartFailsWhenTheBotNeverAnswers(t *testing.T) {
+	t.Setenv(botModeEnv, "mute")
+	_, err := Start("North", 0, Config{
+		Command: []string{os.Args[0], "-test.run=^$"},
+		Timeout: 100 * time.Millisecond,
+	})
+	if err == nil {
+		t.Fatal("Start succeeded against a bot that never answered hello")
+	}
+}
+
+func TestStateHidesOtherHands(t *testing.T) {
+	config := engine.DefaultGameConfig()
+	config.Seed = 11
+	game := engine.NewGame(config)
+	game.StartRound()
+
+	s := NewState(engine.NewGameState(game), 2)
+	if len(s.Hand) != 5 {
+		t.Fatalf("hand has %d cards, want 5", len(s.Hand))
+	}
+	for i, c := range game.Hand(2) {
+		if s.Hand[i] != c {
+			t.Fatalf("state hand = %v, want seat 2's hand %v", s.Hand, game.Hand(2))
+		}
+	}
+	if s.Trump != engine.NoSuit || s.Maker != -1 {
+		t.Errorf("before bidding got trump %v maker %d, want NoSuit and -1", s.Trump, s.Maker)
+	}
+}
+
+func TestStateCoversEveryCutthroatPlayer(t *testing.T) {
+	config := engine.DefaultGameConfig()
+	config.NumPlayers = 3
+	config.Rules.Teams = 3
+	config.Seed = 4
+	game := engine.NewGame(config)
+	game.StartRound()
+
+	for game.Phase() != engine.PhasePlay {
+		legal := game.LegalActions()
+		if err := game.ApplyAction(legal[len(legal)-1]); err != nil {
+			t.Fatalf("apply failed: %v", err)
+		}
+	}
+	for len(game.Round().TrickHistory()) < 3 {
+		if err := game.ApplyAction(game.LegalActions()[0]); err != nil {
+			t.Fatalf("apply failed: %v", err)
+		}
+	}
+
+	s := NewState(engine.NewGameState(game), 2)
+	if s.NumTeams != 3 || len(s.Scores) != 3 || len(s.TricksWon) != 3 {
+		t.Fatalf("state has %d teams, scores %v and tricks won %v; want all three players", s.NumTeams, s.Scores, s.TricksWon)
+	}
+	total := 0
+	for team, won := range s.TricksWon {
+		if won != game.Round().TeamTricksWon(team) {
+			t.Errorf("player %d won %d tricks, state says %d", team, game.Round().TeamTricksWon(team), won)
+		}
+		total += won
+	}
+	if total != 3 {
+		t.Errorf("state counts %d tricks won, want 3", total)
+	}
+}
+
+func TestStateCarriesThePublicBidding(t *testing.T) {
+	config := engine.DefaultGameConfig()
+	config.Seed = 11
+	game := engine.NewGame(config)
+	game.StartRound()
+
+	first := engine.NextPlayer(game.Dealer(), 4)
+	second := engine.NextPlayer(first, 4)
+	for _, a := range []engine.Action{
+		engine.PassAction{PlayerIdx: first},
+		engine.OrderUpAction{PlayerIdx: second, Alone: true},
+	} {
+		if err := game.ApplyAction(a); err != nil {
+			t.Fatalf("apply %v failed: %v", a, err)
+		}
+	}
+	if err := game.ApplyAction(engine.DiscardAction{PlayerIdx: game.Dealer(), Card: game.Hand(game.Dealer())[0]}); err != nil {
+		t.Fatalf("discard failed: %v", err)
+	}
+
+	s := NewState(engine.NewGameState(game), first)
+	if len(s.Actions) != 3 {
+		t.Fatalf("state has actions %+v, want the pass, order-up and discard", s.Actions)
+	}
+	if a := s.Actions[0]; a.Type != engine.ActionPass || a.Player != first {
+		t.Errorf("first action = %+v, want seat %d's pass", a, first)
+	}
+	if a := s.Actions[1]; a.Type != engine.ActionOrderUp || a.Player != second || !a.Alone {
+		t.Errorf("second action = %+v, want seat %d ordering up alone", a, second)
+	}
+	if a := s.Actions[2]; a.Type != engine.ActionDiscard || a.Card != nil {
+		t.Errorf("another seat saw the dealer's discard: %+v", a)
+	}
+	if dealer := NewState(engine.NewGameState(game), game.Dealer()); dealer.Actions[2].Card == nil {
+		t.Error("the dealer should see their own discard")
+	}
+}
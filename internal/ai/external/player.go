@@ -0,0 +1,326 @@
+package external
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// DefaultTimeout is how long a bot gets to answer hello or a decision when
+// Config.Timeout is zero
+const DefaultTimeout = 10 * time.Second
+
+// maxLine bounds a single protocol message from the bot
+const maxLine = 1 << 20
+
+// Config describes how to start a bot
+type Config struct {
+	Command []string      // program and its arguments
+	Dir     string        // working directory; empty uses the current one
+	Timeout time.Duration // for the handshake and each decision
+	Stderr  io.Writer     // receives the bot's standard error; nil discards it
+}
+
+// Register adds a bot to the default AI registry under name, so it can be
+// picked like any built-in strategy. Every seat that uses it starts its own
+// copy of the program.
+func Register(name string, cfg Config) {
+	ai.Register(name, func(playerName string, playerIdx int, _ ai.Difficulty) (ai.Player, error) {
+		return Start(playerName, playerIdx, cfg)
+	})
+}
+
+// Player is an AI player whose decisions are made by an external program.
+//
+// If the bot misbehaves (it exits, sends something that isn't a legal
+// action or takes longer than the timeout) the player stops asking it and
+// makes the first legal move for the rest of the session; Err reports what
+// went wrong.
+type Player struct {
+	name    string
+	seat    int
+	timeout time.Duration
+
+	mu      sync.Mutex
+	cmd     *exec.Cmd
+	in      io.WriteCloser
+	lines   <-chan []byte
+	done    chan struct{}
+	botName string
+	nextID  int
+	err     error
+	closed  bool
+}
+
+var (
+	_ ai.Player = (*Player)(nil)
+	_ io.Closer = (*Player)(nil)
+)
+
+// Start runs the bot program for a seat and waits for it to answer hello
+func Start(name string, seat int, cfg Config) (*Player, error) {
+	if len(cfg.Command) == 0 {
+		return nil, errors.New("external bot: no command")
+	}
+	cmd := exec.Command(cfg.Command[0], cfg.Command[1:]...)
+	cmd.Dir = cfg.Dir
+	cmd.Stderr = cfg.Stderr
+	in, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("external bot: %w", err)
+	}
+	out, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("external bot: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("external bot: %w", err)
+	}
+
+	p := &Player{
+		name:    name,
+		seat:    seat,
+		timeout: cfg.Timeout,
+		cmd:     cmd,
+		in:      in,
+		done:    make(chan struct{}),
+	}
+	if p.timeout <= 0 {
+		p.timeout = DefaultTimeout
+	}
+	p.lines = readLines(out, p.done)
+
+	if err := p.handshake(); err != nil {
+		p.Close()
+		return nil, fmt.Errorf("external bot %s: %w", cfg.Command[0], err)
+	}
+	return p, nil
+}
+
+// readLines delivers each line the bot writes until it closes its output or
+// done is closed.
+func readLines(r io.Reader, done <-chan struct{}) <-chan []byte {
+	lines := make(chan []byte)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxLine)
+		for scanner.Scan() {
+			line := append([]byte(nil), scanner.Bytes()...)
+			select {
+			case lines <- line:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return lines
+}
+
+// handshake sends hello and waits for ready
+func (p *Player) handshake() error {
+	if err := p.send(Hello{Type: TypeHello, Protocol: ProtocolVersion, Seat: p.seat, Name: p.name}); err != nil {
+		return err
+	}
+	var ready Ready
+	if err := p.receive(TypeReady, -1, &ready); err != nil {
+		return err
+	}
+	if ready.Protocol != ProtocolVersion {
+		return fmt.Errorf("bot speaks protocol %d, want %d", ready.Protocol, ProtocolVersion)
+	}
+	p.botName = ready.Name
+	return nil
+}
+
+// send writes one message as a line of JSON
+func (p *Player) send(msg any) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = p.in.Write(append(data, '\n'))
+	return err
+}
+
+// receive waits for the next message of type typ (and, for replies, the
+// given request id), skipping anything else the bot prints.
+func (p *Player) receive(typ string, id int, msg any) error {
+	timer := time.NewTimer(p.timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case line, ok := <-p.lines:
+			if !ok {
+				return errors.New("bot closed its output")
+			}
+			var envelope struct {
+				Type string `json:"type"`
+				ID   int    `json:"id"`
+			}
+			if json.Unmarshal(line, &envelope) != nil || envelope.Type != typ || (id >= 0 && envelope.ID != id) {
+				continue
+			}
+			if err := json.Unmarshal(line, msg); err != nil {
+				return fmt.Errorf("bad %s message: %w", typ, err)
+			}
+			return nil
+		case <-timer.C:
+			return fmt.Errorf("no %s within %v", typ, p.timeout)
+		}
+	}
+}
+
+// Name returns the AI's display name
+func (p *Player) Name() string {
+	return p.name
+}
+
+// BotName returns the name the bot gave in its ready message
+func (p *Player) BotName() string {
+	return p.botName
+}
+
+// Err returns the first protocol failure, or nil if the bot has answered
+// every request with a legal action
+func (p *Player) Err() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.err
+}
+
+// Close tells the bot to quit and waits briefly for it to exit before
+// killing it
+func (p *Player) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return nil
+	}
+	p.closed = true
+	_ = p.send(Quit{Type: TypeQuit})
+	p.in.Close()
+	close(p.done)
+
+	exited := make(chan error, 1)
+	go func() { exited <- p.cmd.Wait() }()
+	select {
+	case err := <-exited:
+		return err
+	case <-time.After(p.timeout):
+		_ = p.cmd.Process.Kill()
+		return <-exited
+	}
+}
+
+// decide asks the bot for the seat's next action. It returns nil when the
+// round has no decision for this seat, and the first legal action once the
+// bot has failed.
+func (p *Player) decide(state *engine.GameState) engine.Action {
+	round := state.Round()
+	if round == nil || round.CurrentPlayer() != p.seat {
+		return nil
+	}
+	legal := round.LegalActions()
+	if len(legal) == 0 {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err == nil && !p.closed {
+		action, err := p.ask(state, legal)
+		if err == nil {
+			return action
+		}
+		p.err = fmt.Errorf("external bot for seat %d: %w", p.seat, err)
+	}
+	return legal[0]
+}
+
+// ask sends a decide request and matches the reply against the legal actions
+func (p *Player) ask(state *engine.GameState, legal []engine.Action) (engine.Action, error) {
+	p.nextID++
+	req := Request{
+		Type:  TypeDecide,
+		ID:    p.nextID,
+		State: NewState(state, p.seat),
+		Legal: make([]engine.ActionRecord, len(legal)),
+	}
+	for i, a := range legal {
+		req.Legal[i] = engine.RecordAction(a)
+	}
+	if err := p.send(req); err != nil {
+		return nil, err
+	}
+
+	var reply Reply
+	if err := p.receive(TypeAction, req.ID, &reply); err != nil {
+		return nil, err
+	}
+	for i, rec := range req.Legal {
+		if sameAction(rec, reply.Action) {
+			return legal[i], nil
+		}
+	}
+	return nil, fmt.Errorf("illegal action %s", reply.Action)
+}
+
+// sameAction reports whether a reply names the legal action rec. The
+// player field is ignored; the seat is already known.
+func sameAction(rec, reply engine.ActionRecord) bool {
+	if rec.Type != reply.Type || rec.Alone != reply.Alone {
+		return false
+	}
+	if (rec.Card == nil) != (reply.Card == nil) || (rec.Card != nil && *rec.Card != *reply.Card) {
+		return false
+	}
+	if (rec.Suit == nil) != (reply.Suit == nil) || (rec.Suit != nil && *rec.Suit != *reply.Suit) {
+		return false
+	}
+	return true
+}
+
+// DecideBid decides what to do during bidding
+func (p *Player) DecideBid(state *engine.GameState, bidRound int) engine.BidDecision {
+	switch a := p.decide(state).(type) {
+	case engine.OrderUpAction:
+		return engine.BidDecision{OrderUp: true, Alone: a.Alone}
+	case engine.CallTrumpAction:
+		return engine.BidDecision{CallSuit: a.Suit, Alone: a.Alone}
+	}
+	return engine.BidDecision{Pass: true}
+}
+
+// DecidePlay chooses which card to play
+func (p *Player) DecidePlay(state *engine.GameState) engine.Card {
+	if a, ok := p.decide(state).(engine.PlayCardAction); ok {
+		return a.Card
+	}
+	return engine.Card{}
+}
+
+// DecideDiscard chooses which card to discard when dealer picks up
+func (p *Player) DecideDiscard(state *engine.GameState, hand []engine.Card) engine.Card {
+	if a, ok := p.decide(state).(engine.DiscardAction); ok {
+		return a.Card
+	}
+	if len(hand) > 0 {
+		return hand[0]
+	}
+	return engine.Card{}
+}
+
+// DecideDefendAlone decides whether to defend alone against a lone maker
+func (p *Player) DecideDefendAlone(state *engine.GameState) bool {
+	_, ok := p.decide(state).(engine.DefendAloneAction)
+	return ok
+}
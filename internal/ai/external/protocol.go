@@ -0,0 +1,161 @@
+// Package external lets a separate program play Euchre through a small
+// line-based JSON protocol, in the spirit of UCI for chess engines.
+//
+// The game starts the bot as a child process and writes one JSON object per
+// line to its standard input; the bot answers with one JSON object per line
+// on its standard output. Anything the bot writes to standard error is
+// passed through for debugging. Every message has a "type":
+//
+//	game -> bot  {"type":"hello","protocol":1,"seat":1,"name":"West"}
+//	bot -> game  {"type":"ready","protocol":1,"name":"MyBot"}
+//
+//	game -> bot  {"type":"decide","id":7,"state":{...},"legal":[...]}
+//	bot -> game  {"type":"action","id":7,"action":{...}}
+//
+//	game -> bot  {"type":"quit"}
+//
+// A decide request carries the seat's observable State and the legal
+// actions, each an engine.ActionRecord such as
+// {"type":"Play Card","player":1,"card":{"suit":"Hearts","rank":"J"}}. The
+// bot replies by echoing one of them, with the request's id.
+//
+// The state's "actions" list is every public action of the hand so far, in
+// order, in the same form: each pass, order-up and call with its player and
+// whether they went alone, then discards, defend-alone decisions and plays.
+// A discarded card is only shown to the player who discarded it. Bidding
+// starts in round 1 left of the dealer (in round 2 with the dealer when the
+// Benny is turned up); an order-up ends round 1, and once every player has
+// passed in round 1 the passes and the call that follow are round 2. The game
+// ignores lines it doesn't understand, so a bot may print other messages of
+// its own; a bot should exit when it reads quit or its input closes.
+package external
+
+import "github.com/BrandonDedolph/euchre/internal/engine"
+
+// ProtocolVersion is the protocol version spoken by this package. A bot
+// that answers hello with a different version is rejected.
+const ProtocolVersion = 1
+
+// Message types
+const (
+	TypeHello  = "hello"
+	TypeReady  = "ready"
+	TypeDecide = "decide"
+	TypeAction = "action"
+	TypeQuit   = "quit"
+)
+
+// Hello is sent once when the bot starts, telling it which seat it plays
+type Hello struct {
+	Type     string `json:"type"`
+	Protocol int    `json:"protocol"`
+	Seat     int    `json:"seat"`
+	Name     string `json:"name"`
+}
+
+// Ready is the bot's answer to Hello
+type Ready struct {
+	Type     string `json:"type"`
+	Protocol int    `json:"protocol"`
+	Name     string `json:"name,omitempty"`
+}
+
+// Request asks the bot for a decision
+type Request struct {
+	Type  string                `json:"type"`
+	ID    int                   `json:"id"`
+	State State                 `json:"state"`
+	Legal []engine.ActionRecord `json:"legal"`
+}
+
+// Reply is the bot's decision for the request with the same ID
+type Reply struct {
+	Type   string              `json:"type"`
+	ID     int                 `json:"id"`
+	Action engine.ActionRecord `json:"action"`
+}
+
+// Quit tells the bot the session is over
+type Quit struct {
+	Type string `json:"type"`
+}
+
+// State is everything one seat can see: its own hand, the face-up stack
+// cards, the turned card, the bidding, the contract, every card played so
+// far and the score. Other hands, face-down cards and other players'
+// discards are never sent.
+type State struct {
+	Seat          int                   `json:"seat"`
+	NumPlayers    int                   `json:"numPlayers"`
+	NumTeams      int                   `json:"numTeams"` // seat p plays for team p % numTeams
+	Phase         engine.GamePhase      `json:"phase"`
+	BidRound      int                   `json:"bidRound"`
+	Dealer        int                   `json:"dealer"`
+	TurnedCard    engine.Card           `json:"turnedCard"`
+	Trump         engine.Suit           `json:"trump"`
+	Maker         int                   `json:"maker"` // -1 until trump is made
+	Alone         bool                  `json:"alone,omitempty"`
+	AloneDefender int                   `json:"aloneDefender"` // -1 unless a defender went alone
+	Hand          []engine.Card         `json:"hand"`
+	Stacks        [][]engine.Card       `json:"stacks,omitempty"` // face-up stack cards on the table, by seat
+	CurrentTrick  []engine.PlayedCard   `json:"currentTrick"`
+	Tricks        []engine.TrickResult  `json:"tricks"`
+	Actions       []engine.ActionRecord `json:"actions"`   // the hand's public actions, in order
+	TricksWon     []int                 `json:"tricksWon"` // by team
+	Scores        []int                 `json:"scores"`    // by team
+	TargetScore   int                   `json:"targetScore"`
+	Rules         engine.Rules          `json:"rules"`
+}
+
+// NewState builds the observable state for seat
+func NewState(state *engine.GameState, seat int) State {
+	round := state.Round()
+	s := State{
+		Seat:          seat,
+		NumPlayers:    state.NumPlayers(),
+		NumTeams:      state.NumTeams(),
+		Phase:         state.Phase(),
+		Dealer:        state.Dealer(),
+		Trump:         engine.NoSuit,
+		Maker:         -1,
+		AloneDefender: -1,
+		Hand:          state.Hand(seat),
+		Scores:        state.Scores(),
+		TargetScore:   state.TargetScore(),
+		Rules:         engine.Rules{StickTheDealer: state.StickTheDealer()},
+		CurrentTrick:  []engine.PlayedCard{},
+		Tricks:        []engine.TrickResult{},
+		Actions:       []engine.ActionRecord{},
+		TricksWon:     make([]int, state.NumTeams()),
+	}
+	if round == nil {
+		return s
+	}
+	s.BidRound = round.BidRound()
+	s.TurnedCard = round.TurnedCard()
+	s.Trump = round.Trump()
+	s.Maker = round.Maker()
+	s.Alone = round.IsAlone()
+	s.AloneDefender = round.AloneDefender()
+	s.Rules = round.Rules()
+	s.CurrentTrick = append(s.CurrentTrick, round.CurrentTrick()...)
+	s.Tricks = append(s.Tricks, round.TrickHistory()...)
+	for _, rec := range round.ActionLog() {
+		if rec.Type == engine.ActionDiscard && rec.Player != seat {
+			rec.Card = nil
+		}
+		s.Actions = append(s.Actions, rec)
+	}
+	for team := range s.TricksWon {
+		s.TricksWon[team] = round.TeamTricksWon(team)
+	}
+	if round.Rules().Stacks > 0 {
+		s.Stacks = make([][]engine.Card, round.NumPlayers())
+		for p := range s.Stacks {
+			for _, st := range round.Stacks(p) {
+				s.Stacks[p] = append(s.Stacks[p], st.Up)
+			}
+		}
+	}
+	return s
+}
@@ -0,0 +1,48 @@
+package external
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// Decider picks one of a request's legal actions
+type Decider func(req Request) engine.ActionRecord
+
+// Serve speaks the bot side of the protocol, reading the game's messages
+// from r and writing replies to w, until the game sends quit or r ends. It
+// is all a bot written in Go needs.
+func Serve(r io.Reader, w io.Writer, name string, decide Decider) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLine)
+	enc := json.NewEncoder(w)
+
+	for scanner.Scan() {
+		var envelope struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &envelope); err != nil {
+			return fmt.Errorf("bad message from game: %w", err)
+		}
+		switch envelope.Type {
+		case TypeHello:
+			if err := enc.Encode(Ready{Type: TypeReady, Protocol: ProtocolVersion, Name: name}); err != nil {
+				return err
+			}
+		case TypeDecide:
+			var req Request
+			if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+				return fmt.Errorf("bad decide message: %w", err)
+			}
+			if err := enc.Encode(Reply{Type: TypeAction, ID: req.ID, Action: decide(req)}); err != nil {
+				return err
+			}
+		case TypeQuit:
+			return nil
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,178 @@
+// Package infoset models what one seat can know about a Euchre round and
+// deals the cards it can't see in ways consistent with that knowledge. Search
+// AIs sample many such layouts ("determinizations") and search each one.
+package infoset
+
+import (
+	"math/rand"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// maxSampleAttempts bounds the rejection sampling in Determinize before it
+// gives up on the void constraints.
+const maxSampleAttempts = 50
+
+// Observation is what one seat knows about a round: its own hand, every card
+// played so far, the face-up stack cards on the table, which suits each seat
+// has shown out of, and where the turned card went. Everything else, the
+// face-down stack cards included, is hidden and gets sampled.
+type Observation struct {
+	seat  int
+	round *engine.Round
+
+	// fixed[i] are cards known to be in seat i's hand (the seat's own hand,
+	// face-up stack cards, and the picked-up card in the dealer's hand).
+	fixed [][]engine.Card
+	// need[i] is how many hidden cards seat i has to play.
+	need []int
+	// voids[i][s] is true once seat i has failed to follow suit s.
+	voids []map[engine.Suit]bool
+	// hidden are the cards whose location the seat doesn't know: the other
+	// hands, the face-down stack cards and the kitty.
+	hidden []engine.Card
+}
+
+// Observe builds seat's view of the round without looking at other hands.
+func Observe(round *engine.Round, seat int) *Observation {
+	n := round.NumPlayers()
+	o := &Observation{
+		seat:  seat,
+		round: round,
+		fixed: make([][]engine.Card, n),
+		need:  make([]int, n),
+		voids: make([]map[engine.Suit]bool, n),
+	}
+	known := make(map[engine.Card]bool)
+
+	own := round.Hand(seat)
+	o.fixed[seat] = own
+	for _, c := range own {
+		known[c] = true
+	}
+	// Face-up stack cards are on the table for everyone to see.
+	for i := 0; i < n; i++ {
+		for _, st := range round.Stacks(i) {
+			if i != seat {
+				o.fixed[i] = append(o.fixed[i], st.Up)
+			}
+			known[st.Up] = true
+		}
+	}
+
+	// Played cards are public, and a card off the led suit shows a void.
+	trump := round.Trump()
+	tricks := round.TrickHistory()
+	current := round.CurrentTrick()
+	if len(current) > 0 {
+		tricks = append(tricks, engine.TrickResult{Cards: current})
+	}
+	for i := range o.voids {
+		o.voids[i] = make(map[engine.Suit]bool)
+	}
+	for _, t := range tricks {
+		if len(t.Cards) == 0 {
+			continue
+		}
+		lead := t.Cards[0].Card.EffectiveSuit(trump)
+		for _, pc := range t.Cards {
+			known[pc.Card] = true
+			if pc.Card.EffectiveSuit(trump) != lead {
+				o.voids[pc.Player][lead] = true
+			}
+		}
+	}
+
+	// The turned card is public. Once ordered up it sits in the dealer's hand
+	// until played; otherwise it stays face down in the kitty.
+	turned := round.TurnedCard()
+	dealer := round.Dealer()
+	pickedUp := false
+	for _, rec := range round.ActionLog() {
+		switch rec.Type {
+		case engine.ActionOrderUp:
+			pickedUp = true
+		case engine.ActionCallTrump:
+			// A turned-up Benny goes to the dealer whatever is called.
+			pickedUp = pickedUp || turned.IsJoker()
+		case engine.ActionDiscard:
+			// Only the player who discarded saw the card.
+			if seat == rec.Player && rec.Card != nil {
+				known[*rec.Card] = true
+			}
+		}
+	}
+	if pickedUp && seat != dealer && !known[turned] {
+		o.fixed[dealer] = append(o.fixed[dealer], turned)
+	}
+	known[turned] = true
+
+	// Even the seat's own face-down stack cards are hidden from it.
+	for i := 0; i < n; i++ {
+		o.need[i] = round.CardsLeft(i) - len(o.fixed[i])
+	}
+	for _, c := range round.Pack() {
+		if !known[c] {
+			o.hidden = append(o.hidden, c)
+		}
+	}
+	return o
+}
+
+// Determinize deals the hidden cards to the other seats, consistent with the
+// voids they have shown where possible, and returns the resulting round.
+// Each seat's last sampled cards go face down under its stacks.
+func (o *Observation) Determinize(rng *rand.Rand) (*engine.Round, error) {
+	hands := o.sample(rng, true)
+	if hands == nil {
+		// Greedy dealing kept running into the voids; a layout that ignores
+		// them is still better than no sample.
+		hands = o.sample(rng, false)
+	}
+	return o.round.WithHands(hands)
+}
+
+// Void reports whether seat has shown out of suit.
+func (o *Observation) Void(seat int, suit engine.Suit) bool {
+	return o.voids[seat][suit]
+}
+
+// sample deals one layout of the hidden cards, or nil if it couldn't honour
+// the void constraints.
+func (o *Observation) sample(rng *rand.Rand, respectVoids bool) [][]engine.Card {
+	trump := o.round.Trump()
+	pool := append([]engine.Card(nil), o.hidden...)
+	used := make([]bool, len(pool))
+
+	for attempt := 0; attempt < maxSampleAttempts; attempt++ {
+		rng.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+		for i := range used {
+			used[i] = false
+		}
+
+		hands := make([][]engine.Card, len(o.fixed))
+		ok := true
+		for seat := range hands {
+			hands[seat] = append([]engine.Card(nil), o.fixed[seat]...)
+			want := len(o.fixed[seat]) + o.need[seat]
+			for k := 0; k < len(pool) && len(hands[seat]) < want; k++ {
+				if used[k] || (respectVoids && o.voids[seat][pool[k].EffectiveSuit(trump)]) {
+					continue
+				}
+				hands[seat] = append(hands[seat], pool[k])
+				used[k] = true
+			}
+			if len(hands[seat]) < want {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return hands
+		}
+		if !respectVoids {
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package infoset
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/engine/enginetest"
+)
+
+func TestDeterminizeKeepsWhatTheSeatKnows(t *testing.T) {
+	round := enginetest.PlayedIntoSecondTrick(t)
+	seat := round.CurrentPlayer()
+	obs := Observe(round, seat)
+	rng := rand.New(rand.NewSource(1))
+
+	played := make(map[engine.Card]bool)
+	for _, tr := range round.TrickHistory() {
+		for _, pc := range tr.Cards {
+			played[pc.Card] = true
+		}
+	}
+	for _, pc := range round.CurrentTrick() {
+		played[pc.Card] = true
+	}
+
+	for i := 0; i < 200; i++ {
+		det, err := obs.Determinize(rng)
+		if err != nil {
+			t.Fatalf("Determinize failed: %v", err)
+		}
+		seen := make(map[engine.Card]bool)
+		for p := 0; p < 4; p++ {
+			hand := det.Hand(p)
+			if len(hand) != len(round.Hand(p)) {
+				t.Fatalf("seat %d got %d cards, holds %d", p, len(hand), len(round.Hand(p)))
+			}
+			for _, c := range hand {
+				if played[c] {
+					t.Fatalf("seat %d was dealt %s, which was already played", p, c)
+				}
+				if seen[c] {
+					t.Fatalf("%s dealt twice", c)
+				}
+				seen[c] = true
+				if obs.Void(p, c.EffectiveSuit(round.Trump())) {
+					t.Fatalf("seat %d showed out of %s but was dealt %s", p, c.EffectiveSuit(round.Trump()), c)
+				}
+			}
+		}
+		for j, c := range round.Hand(seat) {
+			if det.Hand(seat)[j] != c {
+				t.Fatalf("the searching seat's own hand changed")
+			}
+		}
+	}
+}
+
+func TestDeterminizeGivesTheDealerThePickedUpCard(t *testing.T) {
+	round := engine.NewRound(4, 0)
+	deck := engine.NewStandardDeck()
+	deck.Seed(7)
+	round.Deal(deck)
+	if err := round.ApplyAction(engine.OrderUpAction{PlayerIdx: 1}); err != nil {
+		t.Fatalf("order up failed: %v", err)
+	}
+	turned := round.TurnedCard()
+
+	obs := Observe(round, 1)
+	rng := rand.New(rand.NewSource(2))
+	for i := 0; i < 50; i++ {
+		det, err := obs.Determinize(rng)
+		if err != nil {
+			t.Fatalf("Determinize failed: %v", err)
+		}
+		found := false
+		for _, c := range det.Hand(0) {
+			found = found || c == turned
+		}
+		if !found {
+			t.Fatalf("dealer should hold the ordered-up %s in every layout", turned)
+		}
+	}
+}
+
+// TestDeterminizeGuessesFaceDownStacks verifies that face-up stack cards stay
+// where they are on the table, and that the face-down cards beneath them,
+// the seat's own included, are sampled rather than seen.
+func TestDeterminizeGuessesFaceDownStacks(t *testing.T) {
+	round := engine.NewRoundWithRules(2, 0, engine.Rules{AllowMisdeal: true, Stacks: 3})
+	deck := engine.NewStandardDeck()
+	deck.Seed(9)
+	round.Deal(deck)
+	obs := Observe(round, 1)
+	rng := rand.New(rand.NewSource(1))
+
+	moved := false
+	for i := 0; i < 50; i++ {
+		det, err := obs.Determinize(rng)
+		if err != nil {
+			t.Fatalf("Determinize failed: %v", err)
+		}
+		for p := 0; p < 2; p++ {
+			real, guessed := round.Stacks(p), det.Stacks(p)
+			if len(guessed) != len(real) || det.CardsLeft(p) != round.CardsLeft(p) {
+				t.Fatalf("seat %d got %d stacks and %d cards, want %d and %d",
+					p, len(guessed), det.CardsLeft(p), len(real), round.CardsLeft(p))
+			}
+			for k := range real {
+				if guessed[k].Up != real[k].Up {
+					t.Fatalf("seat %d's face-up %v became %v", p, real[k].Up, guessed[k].Up)
+				}
+				moved = moved || guessed[k].Down != real[k].Down
+			}
+		}
+		for _, c := range round.Hand(1) {
+			if !slices.Contains(det.Hand(1), c) {
+				t.Fatalf("the observing seat lost %v from its hand", c)
+			}
+		}
+	}
+	if !moved {
+		t.Error("face-down stack cards were never resampled")
+	}
+}
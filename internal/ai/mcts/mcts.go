@@ -0,0 +1,130 @@
+// Package mcts implements an AI player that searches with Information Set
+// Monte Carlo Tree Search (ISMCTS).
+//
+// For each decision the player repeatedly deals the cards it can't see in a
+// way consistent with what it has observed (its own hand, the turned card,
+// every card played and the suits each seat has shown out of), then walks a
+// single search tree shared by all those layouts, choosing actions from
+// Round.LegalActions. Every bid, discard, defend-alone choice and card play
+// goes through the same search.
+package mcts
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// StrategyName is the name the ISMCTS player is registered under
+const StrategyName = "mcts"
+
+func init() {
+	ai.Register(StrategyName, func(name string, playerIdx int, difficulty ai.Difficulty) (ai.Player, error) {
+		return New(name, playerIdx, difficulty), nil
+	})
+}
+
+// Config tunes the search
+type Config struct {
+	Iterations  int     // determinized playouts per decision
+	Exploration float64 // UCB exploration constant
+	Seed        int64   // search RNG seed; zero picks one from the clock
+}
+
+// DefaultConfig returns the search settings for a difficulty: more playouts
+// make stronger, slower decisions.
+func DefaultConfig(difficulty ai.Difficulty) Config {
+	cfg := Config{Iterations: 1000, Exploration: 0.7}
+	switch difficulty {
+	case ai.DifficultyEasy:
+		cfg.Iterations = 150
+	case ai.DifficultyHard:
+		cfg.Iterations = 3000
+	}
+	return cfg
+}
+
+// Player is an ISMCTS-driven AI player
+type Player struct {
+	name      string
+	playerIdx int
+	cfg       Config
+	rng       *rand.Rand
+}
+
+var _ ai.Player = (*Player)(nil)
+
+// New creates an ISMCTS player with the default settings for difficulty
+func New(name string, playerIdx int, difficulty ai.Difficulty) *Player {
+	return NewWithConfig(name, playerIdx, DefaultConfig(difficulty))
+}
+
+// NewWithConfig creates an ISMCTS player with explicit search settings
+func NewWithConfig(name string, playerIdx int, cfg Config) *Player {
+	if cfg.Iterations <= 0 {
+		cfg.Iterations = DefaultConfig(ai.DifficultyMedium).Iterations
+	}
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &Player{
+		name:      name,
+		playerIdx: playerIdx,
+		cfg:       cfg,
+		rng:       rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Name returns the AI's display name
+func (p *Player) Name() string {
+	return p.name
+}
+
+// decide searches the current position. It returns nil when the round has no
+// decision for this seat.
+func (p *Player) decide(state *engine.GameState) engine.Action {
+	round := state.Round()
+	if round == nil || round.CurrentPlayer() != p.playerIdx || len(round.LegalActions()) == 0 {
+		return nil
+	}
+	return search(round, p.playerIdx, p.cfg, p.rng)
+}
+
+// DecideBid decides what to do during bidding
+func (p *Player) DecideBid(state *engine.GameState, bidRound int) engine.BidDecision {
+	switch a := p.decide(state).(type) {
+	case engine.OrderUpAction:
+		return engine.BidDecision{OrderUp: true, Alone: a.Alone}
+	case engine.CallTrumpAction:
+		return engine.BidDecision{CallSuit: a.Suit, Alone: a.Alone}
+	}
+	return engine.BidDecision{Pass: true}
+}
+
+// DecidePlay chooses which card to play
+func (p *Player) DecidePlay(state *engine.GameState) engine.Card {
+	if a, ok := p.decide(state).(engine.PlayCardAction); ok {
+		return a.Card
+	}
+	return engine.Card{}
+}
+
+// DecideDiscard chooses which card to discard when dealer picks up
+func (p *Player) DecideDiscard(state *engine.GameState, hand []engine.Card) engine.Card {
+	if a, ok := p.decide(state).(engine.DiscardAction); ok {
+		return a.Card
+	}
+	if len(hand) > 0 {
+		return hand[0]
+	}
+	return engine.Card{}
+}
+
+// DecideDefendAlone decides whether to defend alone against a lone maker
+func (p *Player) DecideDefendAlone(state *engine.GameState) bool {
+	_, ok := p.decide(state).(engine.DefendAloneAction)
+	return ok
+}
@@ -0,0 +1,67 @@
+package mcts
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/engine/enginetest"
+	"github.com/BrandonDedolph/euchre/internal/sim"
+)
+
+func TestSearchOrdersUpAnUnbeatableHand(t *testing.T) {
+	c := engine.NewCard
+	h := engine.HandHistory{
+		NumPlayers: 4,
+		Dealer:     0,
+		Rules:      engine.DefaultRules(),
+		Deal: [][]engine.Card{
+			{c(engine.Clubs, engine.Nine), c(engine.Clubs, engine.Ten), c(engine.Clubs, engine.Queen), c(engine.Clubs, engine.King), c(engine.Clubs, engine.Ace)},
+			{c(engine.Hearts, engine.Jack), c(engine.Diamonds, engine.Jack), c(engine.Hearts, engine.Ace), c(engine.Hearts, engine.King), c(engine.Hearts, engine.Queen)},
+			{c(engine.Spades, engine.Nine), c(engine.Spades, engine.Ten), c(engine.Spades, engine.Queen), c(engine.Spades, engine.King), c(engine.Spades, engine.Ace)},
+			{c(engine.Diamonds, engine.Nine), c(engine.Diamonds, engine.Ten), c(engine.Diamonds, engine.Queen), c(engine.Diamonds, engine.King), c(engine.Diamonds, engine.Ace)},
+		},
+		TurnedCard: c(engine.Hearts, engine.Nine),
+	}
+	round, err := h.Replay(0)
+	if err != nil {
+		t.Fatalf("replay failed: %v", err)
+	}
+
+	cfg := Config{Iterations: 800, Exploration: 0.7}
+	a := search(round, 1, cfg, rand.New(rand.NewSource(3)))
+	if _, ok := a.(engine.OrderUpAction); !ok {
+		t.Errorf("holding both bowers and A-K-Q of trump the search chose %v, want an order-up", a)
+	}
+}
+
+func TestPlayerOnlyMakesLegalDecisions(t *testing.T) {
+	players := make([]ai.Player, 4)
+	for i := range players {
+		players[i] = NewWithConfig(ai.PlayerNames[i], i, Config{Iterations: 40, Exploration: 0.7, Seed: int64(i + 1)})
+	}
+	config := engine.DefaultGameConfig()
+	config.Rules = engine.Rules{StickTheDealer: true, AllowDefendAlone: true}
+	config.Seed = 5
+	game := engine.NewGame(config)
+
+	if _, err := sim.PlayGame(game, players); err != nil {
+		t.Fatalf("game with ISMCTS players failed: %v", err)
+	}
+	if !game.IsOver() {
+		t.Error("game should have been played to the end")
+	}
+}
+
+func TestSeededPlayerIsReproducible(t *testing.T) {
+	round := enginetest.PlayedIntoSecondTrick(t)
+	seat := round.CurrentPlayer()
+	cfg := Config{Iterations: 200, Exploration: 0.7}
+
+	first := search(round, seat, cfg, rand.New(rand.NewSource(9)))
+	second := search(round, seat, cfg, rand.New(rand.NewSource(9)))
+	if first != second {
+		t.Errorf("same seed chose %v then %v", first, second)
+	}
+}
@@ -0,0 +1,167 @@
+package mcts
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/BrandonDedolph/euchre/internal/ai/infoset"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// node is a node of the information-set search tree. Children are keyed by
+// action rather than by state, so one tree is shared by every determinization.
+type node struct {
+	action   engine.Action // action that led here (nil at the root)
+	player   int           // seat that took action
+	parent   *node
+	children []*node
+
+	visits int     // times this node was selected
+	avail  int     // times its action was legal when its parent was visited
+	reward float64 // total reward, from player's team's point of view
+}
+
+// child returns the child reached by action, or nil.
+func (n *node) child(action engine.Action) *node {
+	for _, c := range n.children {
+		if c.action == action {
+			return c
+		}
+	}
+	return nil
+}
+
+// ucb scores a child for selection: its average reward plus an exploration
+// bonus that shrinks as it is tried, relative to how often it was available.
+func (n *node) ucb(exploration float64) float64 {
+	return n.reward/float64(n.visits) +
+		exploration*math.Sqrt(math.Log(float64(n.avail))/float64(n.visits))
+}
+
+// search runs single-observer ISMCTS for the seat to act in round and returns
+// the most-visited action.
+func search(round *engine.Round, seat int, cfg Config, rng *rand.Rand) engine.Action {
+	legal := round.LegalActions()
+	if len(legal) == 1 {
+		return legal[0]
+	}
+
+	obs := infoset.Observe(round, seat)
+	root := &node{player: -1}
+	for i := 0; i < cfg.Iterations; i++ {
+		det, err := obs.Determinize(rng)
+		if err != nil {
+			continue
+		}
+		iterate(root, det, cfg.Exploration, rng)
+	}
+
+	// The most-visited root action is the robust choice. Only actions legal
+	// in the real position are considered.
+	best, bestVisits := legal[0], -1
+	for _, a := range legal {
+		if c := root.child(a); c != nil && c.visits > bestVisits {
+			best, bestVisits = a, c.visits
+		}
+	}
+	return best
+}
+
+// iterate runs one select/expand/rollout/backpropagate pass on a determinized
+// round.
+func iterate(root *node, det *engine.Round, exploration float64, rng *rand.Rand) {
+	n := root
+
+	// Selection and expansion.
+	for !det.IsComplete() {
+		legal := det.LegalActions()
+		var untried []engine.Action
+		for _, a := range legal {
+			c := n.child(a)
+			if c == nil {
+				untried = append(untried, a)
+				continue
+			}
+			c.avail++
+		}
+
+		if len(untried) > 0 {
+			a := untried[rng.Intn(len(untried))]
+			c := &node{action: a, player: det.CurrentPlayer(), parent: n, avail: 1}
+			n.children = append(n.children, c)
+			if det.ApplyAction(a) != nil {
+				return
+			}
+			n = c
+			break
+		}
+
+		var best *node
+		bestScore := math.Inf(-1)
+		for _, a := range legal {
+			c := n.child(a)
+			if s := c.ucb(exploration); s > bestScore {
+				best, bestScore = c, s
+			}
+		}
+		if det.ApplyAction(best.action) != nil {
+			return
+		}
+		n = best
+	}
+
+	rollout(det, rng)
+
+	for ; n != root; n = n.parent {
+		n.visits++
+		n.reward += reward(det, det.Team(n.player))
+	}
+	root.visits++
+}
+
+// rollout plays the round out with random legal actions. Loner bids are left
+// out: a random partner-less hand is so often euchred that they would swamp
+// the estimate of every bid that leads to them. The tree still explores them.
+func rollout(det *engine.Round, rng *rand.Rand) {
+	for !det.IsComplete() {
+		legal := det.LegalActions()
+		candidates := legal[:0:0]
+		for _, a := range legal {
+			if !isLonerBid(a) {
+				candidates = append(candidates, a)
+			}
+		}
+		if len(candidates) == 0 {
+			candidates = legal
+		}
+		if det.ApplyAction(candidates[rng.Intn(len(candidates))]) != nil {
+			return
+		}
+	}
+}
+
+// isLonerBid reports whether a is a bid to go alone.
+func isLonerBid(a engine.Action) bool {
+	switch act := a.(type) {
+	case engine.OrderUpAction:
+		return act.Alone
+	case engine.CallTrumpAction:
+		return act.Alone
+	}
+	return false
+}
+
+// reward scores a finished round for team on a 0..1 scale, mapping the point
+// swing from -4 (the other side scores a loner) to +4. A throw-in scores as
+// even.
+func reward(det *engine.Round, team int) float64 {
+	if det.IsMisdeal() || !det.IsComplete() {
+		return 0.5
+	}
+	res := det.Result()
+	swing := res.MakerPoints - res.DefendPoints
+	if team != res.Makers {
+		swing = -swing
+	}
+	return (float64(swing) + 4) / 8
+}
@@ -1,6 +1,13 @@
 package ai
 
-import "github.com/BrandonDedolph/euchre/internal/engine"
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
 
 // Player represents an AI-controlled player
 type Player interface {
@@ -10,7 +17,8 @@ type Player interface {
 	// DecidePlay chooses which card to play
 	DecidePlay(state *engine.GameState) engine.Card
 
-	// DecideDiscard chooses which card to discard (when dealer picks up)
+	// DecideDiscard chooses which card to discard (when dealer picks up, or a
+	// lone maker has been passed cards by their partners)
 	DecideDiscard(state *engine.GameState, hand []engine.Card) engine.Card
 
 	// DecideDefendAlone decides whether to declare a lone defense against a lone
@@ -28,6 +36,9 @@ const (
 	DifficultyEasy Difficulty = iota
 	DifficultyMedium
 	DifficultyHard
+	// DifficultyExpert plays cards by sampling the hidden hands and solving
+	// each layout exactly.
+	DifficultyExpert
 )
 
 func (d Difficulty) String() string {
@@ -38,15 +49,48 @@ func (d Difficulty) String() string {
 		return "Medium"
 	case DifficultyHard:
 		return "Hard"
+	case DifficultyExpert:
+		return "Expert"
 	default:
 		return "Unknown"
 	}
 }
 
+// ParseDifficulty parses a difficulty name ("easy", "medium", "hard", "expert"),
+// ignoring case.
+func ParseDifficulty(s string) (Difficulty, error) {
+	switch strings.ToLower(s) {
+	case "easy":
+		return DifficultyEasy, nil
+	case "medium":
+		return DifficultyMedium, nil
+	case "hard":
+		return DifficultyHard, nil
+	case "expert":
+		return DifficultyExpert, nil
+	default:
+		return DifficultyMedium, fmt.Errorf("unknown difficulty %q (want easy, medium, hard or expert)", s)
+	}
+}
+
 // PlayerNames provides default names for AI players
 var PlayerNames = []string{
 	"Alice",
 	"Bob",
 	"Carol",
 	"Dave",
+	"Erin",
+	"Frank",
+}
+
+// ClosePlayers releases players that hold resources, such as an external
+// bot's process. Players without a Close method are left alone.
+func ClosePlayers(players []Player) error {
+	var errs []error
+	for _, p := range players {
+		if c, ok := p.(io.Closer); ok {
+			errs = append(errs, c.Close())
+		}
+	}
+	return errors.Join(errs...)
 }
@@ -0,0 +1,74 @@
+package ai
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DefaultStrategy is the name of the strategy used when none is chosen
+const DefaultStrategy = "rule-based"
+
+// Factory creates an AI player for a seat at a difficulty. Strategies that
+// start something outside the process, such as an external bot, report a
+// failure to start as an error.
+type Factory func(name string, playerIdx int, difficulty Difficulty) (Player, error)
+
+// Registry holds the AI strategies that can be chosen by name
+type Registry struct {
+	factories map[string]Factory
+}
+
+// NewRegistry creates a new strategy registry
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: make(map[string]Factory),
+	}
+}
+
+// Register adds a strategy to the registry
+func (r *Registry) Register(name string, factory Factory) {
+	r.factories[name] = factory
+}
+
+// Get retrieves a strategy's factory by name
+func (r *Registry) Get(name string) (Factory, bool) {
+	f, ok := r.factories[name]
+	return f, ok
+}
+
+// List returns all registered strategy names in sorted order
+func (r *Registry) List() []string {
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// DefaultRegistry is the global strategy registry
+var DefaultRegistry = NewRegistry()
+
+// Register adds a strategy to the default registry
+func Register(name string, factory Factory) {
+	DefaultRegistry.Register(name, factory)
+}
+
+// Strategies returns the names of all strategies in the default registry
+func Strategies() []string {
+	return DefaultRegistry.List()
+}
+
+// NewPlayer creates a player from the named strategy in the default
+// registry. An empty name picks DefaultStrategy.
+func NewPlayer(strategy, name string, playerIdx int, difficulty Difficulty) (Player, error) {
+	if strategy == "" {
+		strategy = DefaultStrategy
+	}
+	factory, ok := DefaultRegistry.Get(strategy)
+	if !ok {
+		return nil, fmt.Errorf("unknown AI strategy %q (want %s)", strategy, strings.Join(Strategies(), ", "))
+	}
+	return factory(name, playerIdx, difficulty)
+}
@@ -1,21 +1,60 @@
 package rule_based
 
 import (
+	"fmt"
+	"math/rand"
+
 	"github.com/BrandonDedolph/euchre/internal/ai"
 	"github.com/BrandonDedolph/euchre/internal/engine"
 )
 
-// AI implements a rule-based Euchre AI player
-type AI struct {
-	name       string
+func init() {
+	ai.Register(ai.DefaultStrategy, func(name string, playerIdx int, difficulty ai.Difficulty) (ai.Player, error) {
+		return New(name, playerIdx, difficulty), nil
+	})
+}
+
+// Strategy is the rule-based ai.Strategy: hand-strength bidding and
+// heuristic card play
+type Strategy struct {
 	playerIdx  int
 	difficulty ai.Difficulty
 	bidder     *BiddingEvaluator
 	player     *PlayStrategy
+	rng        *rand.Rand // Easy's mistakes and Expert's sampled hands
+	reason     string     // which rule chose the last card
+	lastBid    bidChoice  // Monte Carlo bid for the last position asked about
+}
+
+// bidChoice is a Monte Carlo bid and the position it was made in: the round
+// and how many actions into it. Asking again about the same position (the
+// coach does on every redraw) reuses it.
+type bidChoice struct {
+	round    *engine.Round
+	actions  int
+	decision engine.BidDecision
+	reason   string
+}
+
+var (
+	_ ai.Strategy            = (*Strategy)(nil)
+	_ ai.BidThresholder      = (*Strategy)(nil)
+	_ ai.DefendAloneStrategy = (*Strategy)(nil)
+	_ ai.Reasoner            = (*Strategy)(nil)
+	_ ai.BidChooser          = (*Strategy)(nil)
+)
+
+// New creates a new rule-based AI. Difficulty changes both bidding and play:
+// Easy bids cautiously and sometimes plays a beginner's card, Hard bids by
+// playing each bid out on sampled deals and plays from memory of the cards
+// gone and the contract, and Expert also plays by sampling and solving the
+// hidden hands.
+func New(name string, playerIdx int, difficulty ai.Difficulty) *ai.StrategyPlayer {
+	return ai.NewStrategyPlayer(name, playerIdx, NewStrategy(playerIdx, difficulty))
 }
 
-// New creates a new rule-based AI
-func New(name string, playerIdx int, difficulty ai.Difficulty) *AI {
+// NewStrategy creates the rule-based strategy for a seat
+func NewStrategy(playerIdx int, difficulty ai.Difficulty) *Strategy {
 	// Set bidding threshold based on difficulty
 	threshold := 55 // Medium default
 	switch difficulty {
@@ -23,97 +62,168 @@ func New(name string, playerIdx int, difficulty ai.Difficulty) *AI {
 		threshold = 65 // More conservative
 	case ai.DifficultyMedium:
 		threshold = 55
-	case ai.DifficultyHard:
+	case ai.DifficultyHard, ai.DifficultyExpert:
 		threshold = 45 // More aggressive
 	}
 
-	return &AI{
-		name:       name,
+	s := &Strategy{
 		playerIdx:  playerIdx,
 		difficulty: difficulty,
 		bidder:     NewBiddingEvaluator(threshold),
 		player:     NewPlayStrategy(),
+		// Seeded by seat so a seeded game replays exactly.
+		rng: rand.New(rand.NewSource(int64(playerIdx) + 1)),
 	}
+	if difficulty >= ai.DifficultyHard {
+		s.bidder.UseMonteCarlo(NewMonteCarloBidder(bidSamples, s.rng))
+	}
+	return s
 }
 
-// Name returns the AI's display name
-func (a *AI) Name() string {
-	return a.name
+// Difficulty returns the strategy's configured skill level.
+func (s *Strategy) Difficulty() ai.Difficulty {
+	return s.difficulty
 }
 
-// Difficulty returns the AI's configured skill level.
-func (a *AI) Difficulty() ai.Difficulty {
-	return a.difficulty
+// BidThreshold returns the hand strength at which the strategy bids
+func (s *Strategy) BidThreshold() int {
+	return s.bidder.threshold
 }
 
-// DecideBid decides what to do during bidding
-func (a *AI) DecideBid(state *engine.GameState, bidRound int) engine.BidDecision {
-	hand := state.Hand(a.playerIdx)
-	turnedCard := state.TurnedCard()
-	dealer := state.Dealer()
+// Reason returns which rule chose the card of the last play, lead or discard
+func (s *Strategy) Reason() string {
+	return s.reason
+}
 
-	// Calculate position relative to dealer
-	position := (a.playerIdx - dealer + 4) % 4
-	isDealer := a.playerIdx == dealer
+// ChooseBid decides Hard and Expert bids by playing every bid out on sampled
+// deals. Other difficulties, and positions it can't sample, are left to the
+// strength score.
+func (s *Strategy) ChooseBid(state *engine.GameState, bidRound int) (engine.BidDecision, string, bool) {
+	round := state.Round()
+	if round == nil || round.CurrentPlayer() != s.playerIdx {
+		return engine.BidDecision{}, "", false
+	}
+	actions := len(round.ActionLog())
+	if s.lastBid.round == round && s.lastBid.actions == actions {
+		return s.lastBid.decision, s.lastBid.reason, true
+	}
 
-	decision := engine.BidDecision{Pass: true}
+	options, ok := s.bidder.Decide(round, state.Scores(), state.TargetScore())
+	if !ok {
+		return engine.BidDecision{}, "", false
+	}
+	best := options[0]
+	s.lastBid = bidChoice{round: round, actions: actions, decision: best.Decision, reason: bidReason(best, options)}
+	return s.lastBid.decision, s.lastBid.reason, true
+}
 
-	if bidRound == 1 {
-		shouldBid, goAlone := a.bidder.EvaluateRound1(hand, turnedCard, position, isDealer)
-		if shouldBid {
-			decision.Pass = false
-			decision.OrderUp = true
-			decision.Alone = goAlone
+// bidReason compares the chosen bid with the best alternative, e.g.
+// "averages +0.62 points over sampled deals, against +0.10 to pass"
+func bidReason(best BidOption, options []BidOption) string {
+	reason := fmt.Sprintf("averages %+.2f points over sampled deals", best.Expected)
+	if best.Decision.Pass {
+		if len(options) > 1 {
+			reason += fmt.Sprintf(", against %+.2f to %s", options[1].Expected, ai.DescribeBid(options[1].Decision))
 		}
-	} else {
-		// Round 2 - check if stick the dealer applies. Under stick-the-dealer the
-		// dealer may not pass and must name a legal trump suit, so we must pass the
-		// real rule value through; otherwise a stuck AI dealer returns a pass that
-		// the engine rejects, crashing the game back to the menu.
-		stickTheDealer := state.StickTheDealer()
-
-		shouldBid, suit, goAlone := a.bidder.EvaluateRound2(hand, turnedCard.Suit, isDealer, stickTheDealer)
-		if shouldBid {
-			decision.Pass = false
-			decision.CallSuit = suit
-			decision.Alone = goAlone
+		return reason
+	}
+	for _, o := range options {
+		if o.Decision.Pass {
+			return reason + fmt.Sprintf(", against %+.2f to pass", o.Expected)
 		}
 	}
+	return reason
+}
 
-	return decision
+// EvaluateHandForBid scores a hand for bidding (0-100). In round 1 the score
+// is for ordering up the turned card, adjusted for seat; in round 2 it is for
+// the best suit that can still be called.
+func (s *Strategy) EvaluateHandForBid(hand []engine.Card, turnedCard engine.Card, round int, position int) int {
+	if round == 1 {
+		return s.bidder.round1Strength(hand, turnedCard, position, position == 0)
+	}
+	_, strength := s.bidder.bestRound2Suit(hand, turnedCard.Suit)
+	return strength
 }
 
-// DecidePlay chooses which card to play
-func (a *AI) DecidePlay(state *engine.GameState) engine.Card {
-	hand := state.Hand(a.playerIdx)
-	trump := state.Trump()
+// ShouldGoAlone decides if the hand is strong enough to play without partner
+func (s *Strategy) ShouldGoAlone(hand []engine.Card, trump engine.Suit) bool {
+	return s.bidder.evaluateHandStrength(hand, trump) >= aloneThreshold
+}
 
-	round := state.Round()
-	if round == nil {
+// ChooseTrump picks the strongest suit to call in round 2
+func (s *Strategy) ChooseTrump(hand []engine.Card, excludeSuit engine.Suit) (engine.Suit, bool) {
+	suit, strength := s.bidder.bestRound2Suit(hand, excludeSuit)
+	return suit, suit != engine.NoSuit && strength >= s.bidder.threshold
+}
+
+// SelectDiscard chooses the least valuable card to discard after picking up
+func (s *Strategy) SelectDiscard(hand []engine.Card, trump engine.Suit) engine.Card {
+	if len(hand) == 0 {
 		return engine.Card{}
 	}
+	worst := hand[0]
+	for _, card := range hand[1:] {
+		if s.bidder.cardDiscardValue(card, trump) < s.bidder.cardDiscardValue(worst, trump) {
+			worst = card
+		}
+	}
+	s.reason = discardReason(worst, hand, trump)
+	return worst
+}
 
-	trick := engine.NewTrick(trump)
-	for _, pc := range round.CurrentTrick() {
-		trick.Play(pc.Player, pc.Card)
+// discardReason says why worst is the card to discard from hand
+func discardReason(worst engine.Card, hand []engine.Card, trump engine.Suit) string {
+	if worst.IsTrump(trump) {
+		return "all trump, so let the lowest go"
 	}
+	for _, card := range hand {
+		if card != worst && card.EffectiveSuit(trump) == worst.EffectiveSuit(trump) {
+			return "the weakest side card"
+		}
+	}
+	return "the weakest side card, and it leaves a void to trump into"
+}
 
-	return a.player.SelectPlay(hand, trick, a.playerIdx, trump)
+// SelectLead chooses the card to lead to a new trick
+func (s *Strategy) SelectLead(hand []engine.Card, trump engine.Suit, state *engine.GameState) engine.Card {
+	return s.SelectPlay(hand, engine.NewTrick(trump), state)
 }
 
-// DecideDiscard chooses which card to discard when dealer picks up
-func (a *AI) DecideDiscard(state *engine.GameState, hand []engine.Card) engine.Card {
-	turnedCard := state.TurnedCard()
-	return a.bidder.SelectDiscard(hand, turnedCard)
+// SelectPlay chooses which card to play to trick
+func (s *Strategy) SelectPlay(hand []engine.Card, trick *engine.Trick, state *engine.GameState) engine.Card {
+	trump := trick.Trump()
+	round := state.Round()
+
+	// Hard and Expert remember the cards played and who has shown out.
+	if s.difficulty >= ai.DifficultyHard && round != nil {
+		s.player.Remember(RememberRound(round))
+	} else {
+		s.player.Remember(nil)
+	}
+
+	s.player.Seat(state.NumPlayers(), state.NumTeams())
+	card := s.player.SelectPlay(hand, trick, s.playerIdx, trump)
+	s.reason = s.player.Reason()
+	if s.difficulty == ai.DifficultyEasy && s.rng.Float64() < easyMistakeRate {
+		card = beginnerPlay(hand, trick, trump, s.rng)
+		s.reason = "a beginner's play"
+	}
+	if s.difficulty == ai.DifficultyExpert && round != nil && round.CurrentPlayer() == s.playerIdx &&
+		pimcFits(round, s.playerIdx) {
+		if best := pimcPlay(round, s.playerIdx, pimcSamples, s.rng, card); best != card {
+			card = best
+			s.reason = fmt.Sprintf("takes the most tricks over %d sampled deals", pimcSamples)
+		}
+	}
+	return card
 }
 
-// DecideDefendAlone decides whether to declare a lone defense against a lone
+// ShouldDefendAlone decides whether to declare a lone defense against a lone
 // maker. Defending alone is high risk: you must take 3+ of 5 tricks single-
 // handed to euchre the maker (for 4 points), so the AI is conservative and
 // declines unless the hand is genuinely strong against the known trump.
-func (a *AI) DecideDefendAlone(state *engine.GameState) bool {
-	hand := state.Hand(a.playerIdx)
-	trump := state.Trump()
+func (s *Strategy) ShouldDefendAlone(hand []engine.Card, trump engine.Suit) bool {
 	return shouldDefendAlone(hand, trump)
 }
 
@@ -173,19 +283,3 @@ func shouldDefendAlone(hand []engine.Card, trump engine.Suit) bool {
 
 	return false
 }
-
-// CreateAIPlayers creates AI players for a game
-func CreateAIPlayers(humanPlayer int, difficulty ai.Difficulty) []ai.Player {
-	players := make([]ai.Player, 4)
-
-	for i := 0; i < 4; i++ {
-		if i == humanPlayer {
-			players[i] = nil // Human player slot
-		} else {
-			name := ai.PlayerNames[i]
-			players[i] = New(name, i, difficulty)
-		}
-	}
-
-	return players
-}
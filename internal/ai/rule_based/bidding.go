@@ -2,9 +2,23 @@ package rule_based
 
 import "github.com/BrandonDedolph/euchre/internal/engine"
 
+// aloneThreshold is the hand strength (0-100) at which the AI goes alone
+const aloneThreshold = 85
+
+const (
+	// bidMargin is how close to a threshold a hand's strength must be for the
+	// Monte Carlo bidder to be asked
+	bidMargin = 10
+	// endgamePoints is how near to winning either team must be for every bid
+	// to be played out
+	endgamePoints = 2
+)
+
 // BiddingEvaluator handles bidding decisions
 type BiddingEvaluator struct {
 	threshold int // Minimum strength to bid (0-100)
+	// monteCarlo, when set, decides bids by playing them out instead
+	monteCarlo *MonteCarloBidder
 }
 
 // NewBiddingEvaluator creates a new bidding evaluator
@@ -12,10 +26,103 @@ func NewBiddingEvaluator(threshold int) *BiddingEvaluator {
 	return &BiddingEvaluator{threshold: threshold}
 }
 
+// UseMonteCarlo has the evaluator decide bids by playing them out with m
+// rather than by the strength score
+func (e *BiddingEvaluator) UseMonteCarlo(m *MonteCarloBidder) {
+	e.monteCarlo = m
+}
+
+// Decide values the bids open to the current player of round by Monte
+// Carlo, best first. The strength score settles clear-cut hands on its own,
+// so bids are only played out when the hand is within bidMargin of bidding or
+// going alone, or when either team is close enough to winning that the score
+// should change the bid, and only bids the score doesn't rule out are tried.
+// ok is false when the strength score is left to decide.
+func (e *BiddingEvaluator) Decide(round *engine.Round, scores []int, target int) (options []BidOption, ok bool) {
+	if e.monteCarlo == nil {
+		return nil, false
+	}
+	endgame := false
+	for _, score := range scores {
+		endgame = endgame || target-score <= endgamePoints
+	}
+
+	seat := round.CurrentPlayer()
+	turned := round.TurnedCard()
+	hand := withBenny(round.Hand(seat), turned, seat == round.Dealer())
+	position := (seat - round.Dealer() + round.NumPlayers()) % round.NumPlayers()
+	strengths := make(map[engine.Suit]int)
+	best := 0
+	for _, suit := range []engine.Suit{engine.Clubs, engine.Diamonds, engine.Hearts, engine.Spades} {
+		switch {
+		case round.Phase() == engine.PhaseBidRound1 && suit == turned.Suit:
+			strengths[suit] = e.round1Strength(hand, turned, position, position == 0)
+		case round.Phase() == engine.PhaseBidRound2 && suit != turned.Suit:
+			strengths[suit] = e.evaluateHandStrength(hand, suit)
+		default:
+			continue
+		}
+		best = max(best, strengths[suit])
+	}
+	near := func(strength, threshold int) bool {
+		return endgame || strength >= threshold-bidMargin && strength < threshold+bidMargin
+	}
+	if !near(best, e.threshold) && !near(best, aloneThreshold) {
+		return nil, false
+	}
+
+	// Try passing, and each bid not too weak to consider: going alone only
+	// near the strength for it, and always the strongest suit.
+	var candidates []engine.Action
+	for _, action := range round.LegalActions() {
+		var suit engine.Suit
+		var alone bool
+		switch a := action.(type) {
+		case engine.PassAction:
+			candidates = append(candidates, action)
+			continue
+		case engine.OrderUpAction:
+			suit, alone = turned.Suit, a.Alone
+		case engine.CallTrumpAction:
+			suit, alone = a.Suit, a.Alone
+		}
+		strength := strengths[suit]
+		if alone && strength < aloneThreshold-bidMargin && !endgame {
+			continue
+		}
+		if !alone && strength < e.threshold-bidMargin && strength < best && !endgame {
+			continue
+		}
+		candidates = append(candidates, action)
+	}
+
+	options = e.monteCarlo.Evaluate(round, candidates, scores, target)
+	return options, len(options) > 0
+}
+
+// withBenny returns the hand the dealer will hold when the Benny was turned
+// up, since they take it whatever they call. Other hands are unchanged.
+func withBenny(hand []engine.Card, turned engine.Card, isDealer bool) []engine.Card {
+	if isDealer && turned.IsJoker() {
+		return append(hand, turned)
+	}
+	return hand
+}
+
 // EvaluateRound1 evaluates whether to order up in round 1
 func (e *BiddingEvaluator) EvaluateRound1(hand []engine.Card, turnedCard engine.Card, position int, isDealer bool) (bool, bool) {
-	trump := turnedCard.Suit
-	strength := e.evaluateHandStrength(hand, trump)
+	strength := e.round1Strength(hand, turnedCard, position, isDealer)
+
+	shouldBid := strength >= e.threshold
+	shouldGoAlone := strength >= aloneThreshold // Very strong hand for alone
+
+	return shouldBid, shouldGoAlone
+}
+
+// round1Strength scores a hand for ordering up the turned card, adjusted for
+// the bidder's seat relative to the dealer.
+func (e *BiddingEvaluator) round1Strength(hand []engine.Card, turnedCard engine.Card, position int, isDealer bool) int {
+	strength := e.evaluateHandStrength(hand, turnedCard.Suit)
 
 	// Adjust for position
 	// Dealer gets a boost since they pick up the card
@@ -33,29 +140,12 @@ func (e *BiddingEvaluator) EvaluateRound1(hand []engine.Card, turnedCard engine.
 		strength += 5
 	}
 
-	shouldBid := strength >= e.threshold
-	shouldGoAlone := strength >= 85 // Very strong hand for alone
-
-	return shouldBid, shouldGoAlone
+	return strength
 }
 
 // EvaluateRound2 evaluates whether to call trump in round 2
 func (e *BiddingEvaluator) EvaluateRound2(hand []engine.Card, excludeSuit engine.Suit, isDealer bool, stickTheDealer bool) (bool, engine.Suit, bool) {
-	bestSuit := engine.NoSuit
-	bestStrength := 0
-
-	// Evaluate each possible trump suit
-	for _, suit := range []engine.Suit{engine.Clubs, engine.Diamonds, engine.Hearts, engine.Spades} {
-		if suit == excludeSuit {
-			continue
-		}
-
-		strength := e.evaluateHandStrength(hand, suit)
-		if strength > bestStrength {
-			bestStrength = strength
-			bestSuit = suit
-		}
-	}
+	bestSuit, bestStrength := e.bestRound2Suit(hand, excludeSuit)
 
 	// Dealer must call if stick the dealer is on. The dealer cannot legally pass,
 	// so we must always return a legal, non-excluded suit. If every candidate hand
@@ -70,24 +160,46 @@ func (e *BiddingEvaluator) EvaluateRound2(hand []engine.Card, excludeSuit engine
 				}
 			}
 		}
-		shouldGoAlone := bestStrength >= 85
+		shouldGoAlone := bestStrength >= aloneThreshold
 		return true, bestSuit, shouldGoAlone
 	}
 
 	// Otherwise, need threshold strength
 	if bestStrength >= e.threshold {
-		shouldGoAlone := bestStrength >= 85
+		shouldGoAlone := bestStrength >= aloneThreshold
 		return true, bestSuit, shouldGoAlone
 	}
 
 	return false, engine.NoSuit, false
 }
 
+// bestRound2Suit returns the strongest trump suit other than excludeSuit and
+// its strength, or NoSuit if no suit scores at all.
+func (e *BiddingEvaluator) bestRound2Suit(hand []engine.Card, excludeSuit engine.Suit) (engine.Suit, int) {
+	bestSuit := engine.NoSuit
+	bestStrength := 0
+
+	// Evaluate each possible trump suit
+	for _, suit := range []engine.Suit{engine.Clubs, engine.Diamonds, engine.Hearts, engine.Spades} {
+		if suit == excludeSuit {
+			continue
+		}
+
+		strength := e.evaluateHandStrength(hand, suit)
+		if strength > bestStrength {
+			bestStrength = strength
+			bestSuit = suit
+		}
+	}
+	return bestSuit, bestStrength
+}
+
 // evaluateHandStrength calculates the bidding strength of a hand (0-100)
 func (e *BiddingEvaluator) evaluateHandStrength(hand []engine.Card, trump engine.Suit) int {
 	strength := 0
 
 	trumpCount := 0
+	hasBenny := false
 	hasRightBower := false
 	hasLeftBower := false
 	offAces := 0
@@ -95,6 +207,9 @@ func (e *BiddingEvaluator) evaluateHandStrength(hand []engine.Card, trump engine
 	for _, card := range hand {
 		if card.IsTrump(trump) {
 			trumpCount++
+			if card.IsJoker() {
+				hasBenny = true
+			}
 			if card.IsRightBower(trump) {
 				hasRightBower = true
 			}
@@ -123,6 +238,11 @@ func (e *BiddingEvaluator) evaluateHandStrength(hand []engine.Card, trump engine
 		strength += 95
 	}
 
+	// The Benny outranks both bowers
+	if hasBenny {
+		strength += 15
+	}
+
 	// Bower bonuses
 	if hasRightBower {
 		strength += 15
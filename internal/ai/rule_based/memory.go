@@ -0,0 +1,114 @@
+package rule_based
+
+import (
+	"sort"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// CardMemory is what a player has seen of the round so far: every card
+// played and the suits each player has shown out of. It lets the play
+// strategy tell which trumps are still out and which card is boss in a suit.
+type CardMemory struct {
+	trump  engine.Suit
+	maker  int // seat that called trump, -1 if unknown
+	played map[engine.Card]bool
+	voids  map[int]map[engine.Suit]bool
+	pack   []engine.Card // every card in the deck
+}
+
+// NewCardMemory creates an empty memory for a round with the given trump
+func NewCardMemory(trump engine.Suit) *CardMemory {
+	return &CardMemory{
+		trump:  trump,
+		maker:  -1,
+		played: make(map[engine.Card]bool),
+		voids:  make(map[int]map[engine.Suit]bool),
+		pack:   engine.NewStandardDeck().Cards(),
+	}
+}
+
+// RememberRound builds a memory of the round's completed tricks and the
+// trick in progress.
+func RememberRound(round *engine.Round) *CardMemory {
+	m := NewCardMemory(round.Trump())
+	m.maker = round.Maker()
+	m.pack = round.Pack()
+	for _, t := range round.TrickHistory() {
+		m.observeTrick(t.Cards)
+	}
+	m.observeTrick(round.CurrentTrick())
+	return m
+}
+
+// observeTrick records a trick's cards, noting a void for anyone who didn't
+// follow the suit led.
+func (m *CardMemory) observeTrick(cards []engine.PlayedCard) {
+	if len(cards) == 0 {
+		return
+	}
+	lead := cards[0].Card.EffectiveSuit(m.trump)
+	for _, pc := range cards {
+		m.played[pc.Card] = true
+		if pc.Card.EffectiveSuit(m.trump) != lead {
+			if m.voids[pc.Player] == nil {
+				m.voids[pc.Player] = make(map[engine.Suit]bool)
+			}
+			m.voids[pc.Player][lead] = true
+		}
+	}
+}
+
+// IsPlayed reports whether card has already been played this round
+func (m *CardMemory) IsPlayed(card engine.Card) bool {
+	return m.played[card]
+}
+
+// IsVoid reports whether player has shown out of suit (an effective suit, so
+// the left bower counts as trump)
+func (m *CardMemory) IsVoid(player int, suit engine.Suit) bool {
+	return m.voids[player][suit]
+}
+
+// TrumpsOut returns the trumps that are neither played nor in hand, highest
+// first: the ones other players may still hold.
+func (m *CardMemory) TrumpsOut(hand []engine.Card) []engine.Card {
+	return m.outstanding(m.trump, hand)
+}
+
+// IsBoss reports whether card is the highest card of its suit still in play,
+// counting the cards in hand as accounted for.
+func (m *CardMemory) IsBoss(card engine.Card, hand []engine.Card) bool {
+	suit := card.EffectiveSuit(m.trump)
+	for _, c := range m.outstanding(suit, hand) {
+		if m.rank(c) > m.rank(card) {
+			return false
+		}
+	}
+	return true
+}
+
+// outstanding returns the cards of an effective suit that are neither
+// played nor in hand, highest first.
+func (m *CardMemory) outstanding(suit engine.Suit, hand []engine.Card) []engine.Card {
+	held := make(map[engine.Card]bool, len(hand))
+	for _, c := range hand {
+		held[c] = true
+	}
+	var out []engine.Card
+	for _, c := range m.pack {
+		if c.EffectiveSuit(m.trump) == suit && !m.played[c] && !held[c] {
+			out = append(out, c)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return m.rank(out[i]) > m.rank(out[j]) })
+	return out
+}
+
+// rank orders cards within an effective suit
+func (m *CardMemory) rank(c engine.Card) int {
+	if c.IsTrump(m.trump) {
+		return c.TrumpValue(m.trump)
+	}
+	return c.OffSuitValue()
+}
@@ -0,0 +1,53 @@
+package rule_based
+
+import (
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// memoryAfter returns a memory that has seen the given tricks.
+func memoryAfter(trump engine.Suit, tricks ...[]engine.PlayedCard) *CardMemory {
+	m := NewCardMemory(trump)
+	for _, t := range tricks {
+		m.observeTrick(t)
+	}
+	return m
+}
+
+func TestCardMemory_TracksVoidsAndPlayedCards(t *testing.T) {
+	trump := engine.Hearts
+	m := memoryAfter(trump, []engine.PlayedCard{
+		{Player: 1, Card: engine.NewCard(engine.Spades, engine.Ace)},
+		{Player: 2, Card: engine.NewCard(engine.Spades, engine.Nine)},
+		{Player: 3, Card: engine.NewCard(engine.Diamonds, engine.Jack)}, // left bower: trumped in
+		{Player: 0, Card: engine.NewCard(engine.Clubs, engine.Nine)},
+	})
+
+	if !m.IsPlayed(engine.NewCard(engine.Spades, engine.Ace)) {
+		t.Error("the ace of spades was played")
+	}
+	if !m.IsVoid(3, engine.Spades) || !m.IsVoid(0, engine.Spades) {
+		t.Error("players 3 and 0 showed out of spades")
+	}
+	if m.IsVoid(2, engine.Spades) {
+		t.Error("player 2 followed suit")
+	}
+
+	out := m.TrumpsOut([]engine.Card{engine.NewCard(engine.Hearts, engine.Nine)})
+	if len(out) != 5 || !out[0].IsRightBower(trump) {
+		t.Errorf("trumps out = %v, want the five besides the left bower and our nine, right bower first", out)
+	}
+}
+
+func TestCardMemory_KingIsBossOnceTheAceIsGone(t *testing.T) {
+	king := engine.NewCard(engine.Spades, engine.King)
+	m := NewCardMemory(engine.Hearts)
+	if m.IsBoss(king, []engine.Card{king}) {
+		t.Fatal("the king isn't boss while the ace is out")
+	}
+	m = memoryAfter(engine.Hearts, []engine.PlayedCard{{Player: 1, Card: engine.NewCard(engine.Spades, engine.Ace)}})
+	if !m.IsBoss(king, []engine.Card{king}) {
+		t.Error("the king is boss once the ace has been played")
+	}
+}
@@ -0,0 +1,26 @@
+package rule_based
+
+import (
+	"math/rand"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// easyMistakeRate is how often the Easy AI plays a beginner's card instead
+// of the one its strategy picked.
+const easyMistakeRate = 0.3
+
+// beginnerPlay returns a legal card a beginner might plausibly play: grabbing
+// the trick with the biggest card they hold (wasting a bower, or trumping a
+// trick partner already has), or just any card that follows the rules.
+func beginnerPlay(hand []engine.Card, trick *engine.Trick, trump engine.Suit, rng *rand.Rand) engine.Card {
+	legal := engine.LegalPlays(engine.NewHandWith(hand), trick)
+	if len(legal) == 0 {
+		return engine.Card{}
+	}
+	if rng.Intn(2) == 0 {
+		var s PlayStrategy
+		return s.highestCard(legal, trump)
+	}
+	return legal[rng.Intn(len(legal))]
+}
@@ -0,0 +1,190 @@
+package rule_based
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/BrandonDedolph/euchre/internal/ai/infoset"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// bidSamples is how many layouts of the unseen cards the Monte Carlo bidder
+// plays every bid out on
+const bidSamples = 24
+
+// tableThreshold is the strength at which the simulated table bids, the
+// Medium AI's threshold
+const tableThreshold = 55
+
+// BidOption is a bid the Monte Carlo bidder considered and what it is worth
+type BidOption struct {
+	Decision engine.BidDecision
+	// Expected is the average points the bid is worth to the bidder's team,
+	// less the opponents' points, with neither side credited for more than it
+	// needs to win the game.
+	Expected float64
+}
+
+// MonteCarloBidder values bids by playing them out. It deals the cards the
+// bidder can't see at random, and for each layout plays every bid out to the
+// end of the hand: the rest of the table bids by hand strength, the dealer
+// picks up and discards, and everyone plays with the rule-based play
+// strategy. Seat, the dealer's pick-up and the score all come out of the play
+// rather than a fixed adjustment.
+type MonteCarloBidder struct {
+	samples int
+	rng     *rand.Rand
+	table   *BiddingEvaluator // how the simulated players bid
+	play    *PlayStrategy
+}
+
+// NewMonteCarloBidder creates a bidder that plays each bid out on samples
+// layouts drawn from rng
+func NewMonteCarloBidder(samples int, rng *rand.Rand) *MonteCarloBidder {
+	return &MonteCarloBidder{
+		samples: samples,
+		rng:     rng,
+		table:   NewBiddingEvaluator(tableThreshold),
+		play:    NewPlayStrategy(),
+	}
+}
+
+// Evaluate returns the expected value of each of the current player's bids
+// in actions, best first. scores are the teams' scores and target the score
+// that wins the game. Every bid is played out on the same layouts, so they
+// differ only by the bid.
+func (m *MonteCarloBidder) Evaluate(round *engine.Round, actions []engine.Action, scores []int, target int) []BidOption {
+	seat := round.CurrentPlayer()
+	phase := round.Phase()
+	if len(actions) == 0 || (phase != engine.PhaseBidRound1 && phase != engine.PhaseBidRound2) {
+		return nil
+	}
+	totals := make([]float64, len(actions))
+	team := round.Team(seat)
+	obs := infoset.Observe(round, seat)
+
+	played := 0
+	for i := 0; i < m.samples; i++ {
+		layout, err := obs.Determinize(m.rng)
+		if err != nil {
+			continue
+		}
+		played++
+		for k, action := range actions {
+			r := layout.Clone()
+			if err := r.ApplyAction(action); err != nil {
+				continue
+			}
+			m.playOut(r)
+			totals[k] += scoreValue(r.Result(), team, scores, target)
+		}
+	}
+	if played == 0 {
+		return nil
+	}
+
+	options := make([]BidOption, len(actions))
+	for k, action := range actions {
+		options[k] = BidOption{Decision: bidDecision(action), Expected: totals[k] / float64(played)}
+	}
+	// Stable, so ties keep the engine's order: pass, then the plain bids.
+	sort.SliceStable(options, func(a, b int) bool { return options[a].Expected > options[b].Expected })
+	return options
+}
+
+// playOut finishes a round with the rule-based table
+func (m *MonteCarloBidder) playOut(r *engine.Round) {
+	for !r.IsComplete() {
+		if err := r.ApplyAction(m.tableAction(r)); err != nil {
+			return
+		}
+	}
+}
+
+// tableAction is what the simulated player to act does
+func (m *MonteCarloBidder) tableAction(r *engine.Round) engine.Action {
+	seat := r.CurrentPlayer()
+	hand := r.Hand(seat)
+	trump := r.Trump()
+
+	switch r.Phase() {
+	case engine.PhaseBidRound1:
+		turned := r.TurnedCard()
+		position := (seat - r.Dealer() + r.NumPlayers()) % r.NumPlayers()
+		if m.table.round1Strength(hand, turned, position, position == 0) < m.table.threshold {
+			return engine.PassAction{PlayerIdx: seat}
+		}
+		if position == 0 {
+			hand = append(hand, turned)
+		}
+		alone := m.table.evaluateHandStrength(hand, turned.Suit) >= aloneThreshold
+		return engine.OrderUpAction{PlayerIdx: seat, Alone: alone}
+
+	case engine.PhaseBidRound2:
+		turned := r.TurnedCard()
+		suit, strength := m.table.bestRound2Suit(withBenny(hand, turned, seat == r.Dealer()), turned.Suit)
+		stuck := r.MustCall()
+		if strength < m.table.threshold && !stuck {
+			return engine.PassAction{PlayerIdx: seat}
+		}
+		if suit == engine.NoSuit {
+			for _, s := range []engine.Suit{engine.Clubs, engine.Diamonds, engine.Hearts, engine.Spades} {
+				if s != turned.Suit {
+					suit = s
+					break
+				}
+			}
+		}
+		return engine.CallTrumpAction{PlayerIdx: seat, Suit: suit, Alone: strength >= aloneThreshold}
+
+	case engine.PhaseDiscard:
+		cards := r.Discardable()
+		worst := cards[0]
+		for _, card := range cards[1:] {
+			if m.table.cardDiscardValue(card, trump) < m.table.cardDiscardValue(worst, trump) {
+				worst = card
+			}
+		}
+		return engine.DiscardAction{PlayerIdx: seat, Card: worst}
+
+	case engine.PhaseDefendAlone:
+		if shouldDefendAlone(hand, trump) {
+			return engine.DefendAloneAction{PlayerIdx: seat}
+		}
+		return engine.PassAction{PlayerIdx: seat}
+
+	default:
+		// The table may be Cutthroat or six-handed: seat the playouts to
+		// match, or seats two apart pass for partners.
+		m.play.Seat(r.NumPlayers(), r.NumTeams())
+		card := m.play.SelectPlay(hand, r.Trick(), seat, trump)
+		return engine.PlayCardAction{PlayerIdx: seat, Card: card}
+	}
+}
+
+// scoreValue is what a hand's result is worth to team: its points less the
+// opponents', where points beyond what a side needs to win count for nothing
+func scoreValue(result engine.RoundResult, team int, scores []int, target int) float64 {
+	value := 0
+	for t, score := range scores {
+		points := min(result.Points(t), target-score)
+		if t == team {
+			value += points
+		} else {
+			value -= points
+		}
+	}
+	return float64(value)
+}
+
+// bidDecision converts a bidding action into the decision that makes it
+func bidDecision(action engine.Action) engine.BidDecision {
+	switch a := action.(type) {
+	case engine.OrderUpAction:
+		return engine.BidDecision{OrderUp: true, Alone: a.Alone}
+	case engine.CallTrumpAction:
+		return engine.BidDecision{CallSuit: a.Suit, Alone: a.Alone}
+	default:
+		return engine.BidDecision{Pass: true}
+	}
+}
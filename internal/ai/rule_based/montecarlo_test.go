@@ -0,0 +1,142 @@
+package rule_based
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// dealFor deals a round with dealer 0 in which seat 1, first to bid, holds
+// hand and turned is face up. The other seats get the rest of the deck.
+func dealFor(t *testing.T, hand []engine.Card, turned engine.Card) *engine.Round {
+	t.Helper()
+	used := map[engine.Card]bool{turned: true}
+	for _, c := range hand {
+		used[c] = true
+	}
+	var rest []engine.Card
+	for _, c := range engine.NewStandardDeck().Cards() {
+		if !used[c] {
+			rest = append(rest, c)
+		}
+	}
+	deal := [][]engine.Card{rest[0:5], hand, rest[5:10], rest[10:15]}
+	round, err := engine.HandHistory{NumPlayers: 4, Dealer: 0, Rules: engine.DefaultRules(), Deal: deal, TurnedCard: turned}.Replay(0)
+	if err != nil {
+		t.Fatalf("deal failed: %v", err)
+	}
+	return round
+}
+
+var lockHand = []engine.Card{
+	{Suit: engine.Hearts, Rank: engine.Jack},
+	{Suit: engine.Diamonds, Rank: engine.Jack},
+	{Suit: engine.Hearts, Rank: engine.Ace},
+	{Suit: engine.Hearts, Rank: engine.King},
+	{Suit: engine.Spades, Rank: engine.Ace},
+}
+
+func TestMonteCarloBidderOrdersUpALock(t *testing.T) {
+	round := dealFor(t, lockHand, engine.Card{Suit: engine.Hearts, Rank: engine.Nine})
+	m := NewMonteCarloBidder(bidSamples, rand.New(rand.NewSource(1)))
+
+	options := m.Evaluate(round, round.LegalActions(), []int{0, 0}, 10)
+	if len(options) != 3 {
+		t.Fatalf("got %d options, want pass, order up and alone", len(options))
+	}
+	if best := options[0].Decision; !best.OrderUp || !best.Alone {
+		t.Errorf("best bid with both bowers, A-K of trump and an off ace = %+v, want alone; options %+v", best, options)
+	}
+	if last := options[len(options)-1].Decision; !last.Pass {
+		t.Errorf("passing a lock should rank last, got %+v", options)
+	}
+}
+
+func TestMonteCarloBidderDoesntGoAloneForPointsItCantUse(t *testing.T) {
+	round := dealFor(t, lockHand, engine.Card{Suit: engine.Hearts, Rank: engine.Nine})
+	m := NewMonteCarloBidder(bidSamples, rand.New(rand.NewSource(1)))
+
+	// Seat 1's team needs one point: a loner's extra points win nothing more.
+	options := m.Evaluate(round, round.LegalActions(), []int{0, 9}, 10)
+	if best := options[0].Decision; !best.OrderUp || best.Alone {
+		t.Errorf("needing one point, best bid = %+v, want a plain order up; options %+v", best, options)
+	}
+}
+
+func TestHardFallsBackToMonteCarloOnCloseBids(t *testing.T) {
+	// A middling hand: one bower and a short trump suit.
+	hand := []engine.Card{
+		{Suit: engine.Hearts, Rank: engine.Jack},
+		{Suit: engine.Hearts, Rank: engine.Ten},
+		{Suit: engine.Spades, Rank: engine.King},
+		{Suit: engine.Clubs, Rank: engine.Queen},
+		{Suit: engine.Diamonds, Rank: engine.Nine},
+	}
+	turned := engine.Card{Suit: engine.Hearts, Rank: engine.Nine}
+	round := dealFor(t, hand, turned)
+
+	hard := NewStrategy(1, ai.DifficultyHard)
+	strength := hard.bidder.round1Strength(hand, turned, 1, false)
+	if strength < hard.BidThreshold()-bidMargin || strength >= hard.BidThreshold()+bidMargin {
+		t.Fatalf("test hand strength %d isn't near Hard's threshold %d", strength, hard.BidThreshold())
+	}
+	if _, ok := hard.bidder.Decide(round, []int{0, 0}, 10); !ok {
+		t.Error("Hard should play out a close bid")
+	}
+	if _, ok := NewStrategy(1, ai.DifficultyMedium).bidder.Decide(round, []int{0, 0}, 10); ok {
+		t.Error("Medium bids by strength score alone")
+	}
+
+	// A hand nowhere near bidding is left to the score, until the endgame.
+	weak := dealFor(t, []engine.Card{
+		{Suit: engine.Spades, Rank: engine.Nine},
+		{Suit: engine.Spades, Rank: engine.Ten},
+		{Suit: engine.Clubs, Rank: engine.Nine},
+		{Suit: engine.Clubs, Rank: engine.Ten},
+		{Suit: engine.Diamonds, Rank: engine.Ten},
+	}, turned)
+	if _, ok := hard.bidder.Decide(weak, []int{0, 0}, 10); ok {
+		t.Error("a hopeless hand shouldn't need playing out")
+	}
+	if _, ok := hard.bidder.Decide(weak, []int{8, 3}, 10); !ok {
+		t.Error("with a team two points from winning, every bid should be played out")
+	}
+}
+
+func TestMonteCarloPlayoutsSeatCutthroat(t *testing.T) {
+	// Three players, each on their own: seat 2 orders up, leads the queen of
+	// spades, and seat 0 must take it from an opponent, not duck a partner.
+	deal := [][]engine.Card{
+		{{Suit: engine.Spades, Rank: engine.Nine}, {Suit: engine.Spades, Rank: engine.Ace}, {Suit: engine.Clubs, Rank: engine.Nine}, {Suit: engine.Clubs, Rank: engine.Ten}, {Suit: engine.Diamonds, Rank: engine.Nine}},
+		{{Suit: engine.Clubs, Rank: engine.Queen}, {Suit: engine.Clubs, Rank: engine.King}, {Suit: engine.Clubs, Rank: engine.Ace}, {Suit: engine.Diamonds, Rank: engine.Ten}, {Suit: engine.Diamonds, Rank: engine.Queen}},
+		{{Suit: engine.Spades, Rank: engine.Queen}, {Suit: engine.Hearts, Rank: engine.Jack}, {Suit: engine.Hearts, Rank: engine.Ace}, {Suit: engine.Hearts, Rank: engine.King}, {Suit: engine.Diamonds, Rank: engine.King}},
+	}
+	history := engine.HandHistory{
+		NumPlayers: 3,
+		Dealer:     1,
+		Rules:      engine.Rules{Teams: 3},
+		Deal:       deal,
+		TurnedCard: engine.Card{Suit: engine.Hearts, Rank: engine.Nine},
+	}
+	round, err := history.Replay(0)
+	if err != nil {
+		t.Fatalf("deal failed: %v", err)
+	}
+	for _, action := range []engine.Action{
+		engine.OrderUpAction{PlayerIdx: 2},
+		engine.DiscardAction{PlayerIdx: 1, Card: engine.Card{Suit: engine.Diamonds, Rank: engine.Ten}},
+		engine.PlayCardAction{PlayerIdx: 2, Card: engine.Card{Suit: engine.Spades, Rank: engine.Queen}},
+	} {
+		if err := round.ApplyAction(action); err != nil {
+			t.Fatalf("%v failed: %v", action, err)
+		}
+	}
+
+	m := NewMonteCarloBidder(bidSamples, rand.New(rand.NewSource(1)))
+	want := engine.PlayCardAction{PlayerIdx: 0, Card: engine.Card{Suit: engine.Spades, Rank: engine.Ace}}
+	if got := m.tableAction(round); got != want {
+		t.Errorf("seat 0 played %v under seat 2's queen, want the ace: seat 2 is an opponent", got)
+	}
+}
@@ -0,0 +1,116 @@
+package rule_based
+
+import (
+	"math/rand"
+
+	"github.com/BrandonDedolph/euchre/internal/ai/infoset"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/solver"
+)
+
+// pimcSamples is how many layouts of the hidden cards the Expert AI solves
+// for each card it plays.
+const pimcSamples = 30
+
+// pimcMaxCards is the most cards the Expert AI solves a hand with, and
+// pimcMaxTableCards the most left in all the hands together, a four-handed
+// deal. Solving grows steeply with both, so the longer hands of two-handed
+// Euchre and the six hands of six-handed are played by the ordinary
+// strategy until they are down to these.
+const (
+	pimcMaxCards      = 7
+	pimcMaxTableCards = 20
+)
+
+// pimcFits reports whether the round is small enough for the Expert AI to
+// solve seat's play. The solver only searches two sides, so Cutthroat and
+// three-partnership six-handed are always left to the ordinary strategy.
+func pimcFits(round *engine.Round, seat int) bool {
+	if round.NumTeams() > 2 {
+		return false
+	}
+	if round.CardsLeft(seat) > pimcMaxCards {
+		return false
+	}
+	total := 0
+	for p := 0; p < round.NumPlayers(); p++ {
+		total += round.CardsLeft(p)
+	}
+	return total <= pimcMaxTableCards
+}
+
+// pimcPlay picks a card by perfect-information Monte Carlo: it deals the
+// cards seat can't see many times over, solves every layout double-dummy and
+// plays the card with the best average score for seat's team. Ties go to
+// fallback, the card the ordinary play strategy would choose, so equally good
+// options are played the way a person would.
+func pimcPlay(round *engine.Round, seat, samples int, rng *rand.Rand, fallback engine.Card) engine.Card {
+	obs := infoset.Observe(round, seat)
+	team := round.Team(seat)
+
+	var cards []engine.Card
+	totals := make(map[engine.Card]float64)
+	for i := 0; i < samples; i++ {
+		det, err := obs.Determinize(rng)
+		if err != nil {
+			continue
+		}
+		pos, err := solver.FromRound(det)
+		if err != nil {
+			continue
+		}
+		plays, err := solver.EvaluatePlays(pos)
+		if err != nil {
+			continue
+		}
+		for _, p := range plays {
+			if _, seen := totals[p.Card]; !seen {
+				cards = append(cards, p.Card)
+			}
+			totals[p.Card] += pimcScore(round, team, p.Tricks[team])
+		}
+	}
+
+	if _, ok := totals[fallback]; !ok || len(cards) == 0 {
+		return fallback
+	}
+	best := fallback
+	for _, c := range cards {
+		if totals[c] > totals[best] {
+			best = c
+		}
+	}
+	return best
+}
+
+// pimcScore rates a hand that ends with team taking tricks more of the
+// remaining tricks. It is the team's point swing for the hand, plus a small
+// bonus per trick so that, among results worth the same points, more tricks
+// are preferred.
+func pimcScore(round *engine.Round, team, tricks int) float64 {
+	total := round.Rules().TrickCount()
+	mine := round.TeamTricksWon(team) + tricks
+	makerTricks := mine
+	if team != round.MakerTeam() {
+		makerTricks = total - mine
+	}
+
+	var swing int
+	switch {
+	case makerTricks <= total/2:
+		swing = -2
+		if round.AloneDefender() >= 0 {
+			swing = -4
+		}
+	case makerTricks == total && round.IsAlone():
+		swing = 4
+	case makerTricks == total:
+		swing = 2
+	default:
+		swing = 1
+	}
+	if team != round.MakerTeam() {
+		swing = -swing
+	}
+	return float64(swing) + 0.01*float64(mine)
+}
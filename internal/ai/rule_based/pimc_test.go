@@ -0,0 +1,98 @@
+package rule_based
+
+import (
+	"math"
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/sim"
+)
+
+func TestExpertPlaysOnlyLegalCards(t *testing.T) {
+	config := engine.DefaultGameConfig()
+	config.Rules = engine.Rules{StickTheDealer: true, AllowDefendAlone: true}
+	config.Seed = 11
+	game := engine.NewGame(config)
+
+	players := make([]ai.Player, 4)
+	for i := range players {
+		players[i] = New(ai.PlayerNames[i], i, ai.DifficultyExpert)
+	}
+
+	// A few hands are enough to cover leads, follows and discards.
+	for hands := 0; hands < 3; hands++ {
+		game.StartRound()
+		for !game.Round().IsComplete() {
+			action, err := sim.Decide(game, players[game.CurrentPlayer()])
+			if err != nil {
+				t.Fatalf("decide failed: %v", err)
+			}
+			if err := game.ApplyAction(action); err != nil {
+				t.Fatalf("hand %d: Expert chose an illegal %v: %v", hands+1, action, err)
+			}
+		}
+	}
+}
+
+func TestPIMCScoreFollowsHandScoring(t *testing.T) {
+	round := engine.NewRound(4, 0)
+	deck := engine.NewStandardDeck()
+	deck.Seed(1)
+	round.Deal(deck)
+	if err := round.ApplyAction(engine.OrderUpAction{PlayerIdx: 1}); err != nil {
+		t.Fatalf("order up failed: %v", err)
+	}
+
+	// Seat 1 made trump, so team 1 are the makers with no tricks taken yet.
+	tests := []struct {
+		team, tricks int
+		swing        int
+	}{
+		{1, 5, 2},  // march
+		{1, 3, 1},  // made it
+		{1, 2, -2}, // euchred
+		{0, 3, 2},  // defenders euchre the makers
+		{0, 0, -2}, // defenders are marched
+		{0, 1, -1}, // makers take four
+	}
+	for _, tt := range tests {
+		got := pimcScore(round, tt.team, tt.tricks)
+		// The per-trick tiebreak bonus is far smaller than a point.
+		if math.Round(got) != float64(tt.swing) {
+			t.Errorf("team %d taking %d: score %.2f, want a swing of %d", tt.team, tt.tricks, got, tt.swing)
+		}
+	}
+}
+
+func TestPIMCLeavesThreeSidedTablesToTheOrdinaryStrategy(t *testing.T) {
+	// A Cutthroat hand is small enough to solve, but the solver only
+	// searches two sides.
+	round := engine.NewRoundWithRules(3, 0, engine.Rules{Teams: 3})
+	deck := engine.NewStandardDeck()
+	deck.Seed(1)
+	round.Deal(deck)
+	if err := round.ApplyAction(engine.OrderUpAction{PlayerIdx: 1}); err != nil {
+		t.Fatalf("order up failed: %v", err)
+	}
+	if err := round.ApplyAction(engine.DiscardAction{PlayerIdx: 0, Card: round.Hand(0)[0]}); err != nil {
+		t.Fatalf("discard failed: %v", err)
+	}
+	if pimcFits(round, round.CurrentPlayer()) {
+		t.Error("Expert should not solve a three-sided hand")
+	}
+
+	round = engine.NewRound(4, 0)
+	deck = engine.NewStandardDeck()
+	deck.Seed(1)
+	round.Deal(deck)
+	if err := round.ApplyAction(engine.OrderUpAction{PlayerIdx: 1}); err != nil {
+		t.Fatalf("order up failed: %v", err)
+	}
+	if err := round.ApplyAction(engine.DiscardAction{PlayerIdx: 0, Card: round.Hand(0)[0]}); err != nil {
+		t.Fatalf("discard failed: %v", err)
+	}
+	if !pimcFits(round, round.CurrentPlayer()) {
+		t.Error("Expert should solve a four-handed hand")
+	}
+}
@@ -3,11 +3,58 @@ package rule_based
 import "github.com/BrandonDedolph/euchre/internal/engine"
 
 // PlayStrategy handles card play decisions
-type PlayStrategy struct{}
+type PlayStrategy struct {
+	// memory is what has been seen of the round so far. Without it the
+	// strategy decides from the hand and the current trick alone.
+	memory *CardMemory
+	// reason names the rule that chose the last card
+	reason string
+	// players and teams describe the table: seat p plays for team p % teams
+	players, teams int
+}
 
-// NewPlayStrategy creates a new play strategy
+// NewPlayStrategy creates a new play strategy for four players in two
+// partnerships
 func NewPlayStrategy() *PlayStrategy {
-	return &PlayStrategy{}
+	return &PlayStrategy{players: 4, teams: 2}
+}
+
+// Seat tells the strategy how the table is seated
+func (s *PlayStrategy) Seat(numPlayers, numTeams int) {
+	s.players, s.teams = numPlayers, numTeams
+}
+
+// sameTeam reports whether two seats play for the same team
+func (s *PlayStrategy) sameTeam(a, b int) bool {
+	return a >= 0 && b >= 0 && engine.TeamOf(a, s.teams) == engine.TeamOf(b, s.teams)
+}
+
+// opponents returns the seats playing against playerIdx
+func (s *PlayStrategy) opponents(playerIdx int) []int {
+	var seats []int
+	for p := 0; p < s.players; p++ {
+		if !s.sameTeam(p, playerIdx) {
+			seats = append(seats, p)
+		}
+	}
+	return seats
+}
+
+// Remember gives the strategy a memory of the round to play from, or nil to
+// play from the current trick alone
+func (s *PlayStrategy) Remember(memory *CardMemory) {
+	s.memory = memory
+}
+
+// Reason returns which rule chose the card of the last SelectPlay
+func (s *PlayStrategy) Reason() string {
+	return s.reason
+}
+
+// because records why card was chosen and returns it
+func (s *PlayStrategy) because(reason string, card engine.Card) engine.Card {
+	s.reason = reason
+	return card
 }
 
 // SelectPlay chooses the best card to play from the legal options
@@ -15,10 +62,10 @@ func (s *PlayStrategy) SelectPlay(hand []engine.Card, trick *engine.Trick, playe
 	// Get legal plays
 	legalPlays := engine.LegalPlays(engine.NewHandWith(hand), trick)
 	if len(legalPlays) == 0 {
-		return engine.Card{} // Shouldn't happen
+		return s.because("", engine.Card{}) // Shouldn't happen
 	}
 	if len(legalPlays) == 1 {
-		return legalPlays[0] // Only one choice
+		return s.because("the only legal card", legalPlays[0])
 	}
 
 	// If leading, use lead strategy
@@ -32,6 +79,10 @@ func (s *PlayStrategy) SelectPlay(hand []engine.Card, trick *engine.Trick, playe
 
 // selectLead chooses the best card to lead
 func (s *PlayStrategy) selectLead(options []engine.Card, trump engine.Suit, playerIdx int) engine.Card {
+	if s.memory != nil {
+		return s.selectLeadFromMemory(options, trump, playerIdx)
+	}
+
 	// If we have trump, consider leading it
 	var trumps []engine.Card
 	var offSuit []engine.Card
@@ -46,23 +97,113 @@ func (s *PlayStrategy) selectLead(options []engine.Card, trump engine.Suit, play
 
 	// If we have multiple trumps, lead our highest trump to draw out opponents' trumps
 	if len(trumps) >= 2 {
-		return s.highestCard(trumps, trump)
+		return s.because("lead high trump to draw the opponents' trump", s.highestCard(trumps, trump))
 	}
 
 	// If we have off-suit aces, lead them
 	for _, card := range offSuit {
 		if card.Rank == engine.Ace {
-			return card
+			return s.because("cash an off-suit ace", card)
 		}
 	}
 
 	// Lead lowest off-suit to preserve trumps
 	if len(offSuit) > 0 {
-		return s.lowestCard(offSuit)
+		return s.because("lead low off-suit to keep trump back", s.lowestCard(offSuit, trump))
 	}
 
-	// Only have trumps, lead the lowest to preserve bowers
-	return s.lowestCard(trumps)
+	// Only have trumps, lead the lowest to preserve the high ones
+	return s.because("only trump left, so lead the lowest", s.lowestTrump(trumps, trump))
+}
+
+// selectLeadFromMemory chooses a lead knowing which cards are gone: pull
+// trump while the opponents may still hold some, cash cards that are now
+// boss, and stay out of suits an opponent has shown out of.
+func (s *PlayStrategy) selectLeadFromMemory(options []engine.Card, trump engine.Suit, playerIdx int) engine.Card {
+	var trumps, offSuit []engine.Card
+	for _, card := range options {
+		if card.IsTrump(trump) {
+			trumps = append(trumps, card)
+		} else {
+			offSuit = append(offSuit, card)
+		}
+	}
+	trumpsOut := len(s.memory.TrumpsOut(options)) > 0
+
+	// Pull trump when our side called it: the maker with length leads from
+	// the top, and the maker's partner leads one back to them. Defenders
+	// keep their trumps for ruffing.
+	switch {
+	case !trumpsOut || len(trumps) == 0:
+	case s.memory.maker != playerIdx && s.sameTeam(s.memory.maker, playerIdx):
+		top := s.highestCard(trumps, trump)
+		if s.memory.IsBoss(top, options) {
+			return s.because("pull trump with the boss trump for partner, who called it", top)
+		}
+		return s.because("lead trump back to partner, who called it", s.lowestTrump(trumps, trump))
+	case len(trumps) >= 2 && (s.memory.maker < 0 || s.sameTeam(s.memory.maker, playerIdx)):
+		return s.because("pull trump from the top while the opponents may hold some", s.highestCard(trumps, trump))
+	}
+
+	// A suit is safe to lead unless an opponent can trump it.
+	opponents := s.opponents(playerIdx)
+	safe := func(suit engine.Suit) bool {
+		if !trumpsOut {
+			return true
+		}
+		for _, opp := range opponents {
+			if s.memory.IsVoid(opp, suit) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, card := range offSuit {
+		if s.memory.IsBoss(card, options) && safe(card.Suit) {
+			return s.because("cash a boss card that no opponent can trump", card)
+		}
+	}
+	if len(trumps) > 0 && !trumpsOut {
+		// Every trump left is ours, so it can't lose.
+		return s.because("every trump left is ours, so it can't lose", s.highestCard(trumps, trump))
+	}
+
+	var safeSuits []engine.Card
+	for _, card := range offSuit {
+		if safe(card.Suit) {
+			safeSuits = append(safeSuits, card)
+		}
+	}
+	if len(safeSuits) > 0 {
+		return s.because("lead low in a suit no opponent has shown out of", s.lowestCard(safeSuits, trump))
+	}
+	if len(offSuit) > 0 {
+		return s.because("lead low off-suit to keep trump back", s.lowestCard(offSuit, trump))
+	}
+	return s.because("only trump left, so lead the lowest", s.lowestTrump(trumps, trump))
+}
+
+// partnerHasItWon reports whether partner's winning card will hold up: it is
+// boss, and no opponent still to play can trump it.
+func (s *PlayStrategy) partnerHasItWon(trick *engine.Trick, hand []engine.Card, playerIdx int, trump engine.Suit) bool {
+	winningCard, _ := trick.WinningCard()
+	if !s.memory.IsBoss(winningCard, hand) {
+		return false
+	}
+	if winningCard.IsTrump(trump) || len(s.memory.TrumpsOut(hand)) == 0 {
+		return true
+	}
+	played := make(map[int]bool)
+	for _, pc := range trick.Cards() {
+		played[pc.Player] = true
+	}
+	for _, opp := range s.opponents(playerIdx) {
+		if !played[opp] && s.memory.IsVoid(opp, trick.LeadSuit()) {
+			return false
+		}
+	}
+	return true
 }
 
 // selectFollow chooses the best card when following
@@ -70,7 +211,7 @@ func (s *PlayStrategy) selectFollow(options []engine.Card, trick *engine.Trick,
 	leadSuit := trick.LeadSuit()
 	winningCard, _ := trick.WinningCard()
 	winningPlayer := trick.Winner()
-	isPartnerWinning := engine.IsPartner(playerIdx, winningPlayer)
+	isPartnerWinning := s.sameTeam(playerIdx, winningPlayer)
 
 	// Separate options by type
 	var followSuit []engine.Card
@@ -93,20 +234,25 @@ func (s *PlayStrategy) selectFollow(options []engine.Card, trick *engine.Trick,
 		return s.playFollowSuit(followSuit, winningCard, isPartnerWinning, trump)
 	}
 
-	// Can't follow suit - decide whether to trump or discard
+	// Can't follow suit - decide whether to trump or discard. Trumping
+	// partner's trick wastes a trump if it was going to win anyway.
+	if len(trumps) > 0 && len(offSuit) > 0 && isPartnerWinning && s.memory != nil &&
+		s.partnerHasItWon(trick, options, playerIdx, trump) {
+		return s.because("partner's card will hold, so save trump and throw off", s.selectDiscard(offSuit, trump))
+	}
 	if len(trumps) > 0 {
 		return s.playTrump(trumps, trick, isPartnerWinning, trump)
 	}
 
 	// Can only discard
-	return s.selectDiscard(offSuit)
+	return s.because("can't follow or trump, so throw the lowest card", s.selectDiscard(offSuit, trump))
 }
 
 // playFollowSuit chooses the best card when following suit
 func (s *PlayStrategy) playFollowSuit(options []engine.Card, winningCard engine.Card, isPartnerWinning bool, trump engine.Suit) engine.Card {
 	if isPartnerWinning {
 		// Partner is winning - play our lowest card
-		return s.lowestCard(options)
+		return s.because("partner is winning, so play low", s.lowestCard(options, trump))
 	}
 
 	// Try to beat the winning card with minimum effort
@@ -119,11 +265,11 @@ func (s *PlayStrategy) playFollowSuit(options []engine.Card, winningCard engine.
 
 	if len(beaters) > 0 {
 		// Play the lowest card that beats the current winner
-		return s.lowestCard(beaters)
+		return s.because("the lowest card that beats the winner", s.lowestCard(beaters, trump))
 	}
 
 	// Can't beat it - play lowest
-	return s.lowestCard(options)
+	return s.because("can't beat the winner, so play low", s.lowestCard(options, trump))
 }
 
 // playTrump decides whether and how to trump
@@ -131,7 +277,7 @@ func (s *PlayStrategy) playTrump(trumps []engine.Card, trick *engine.Trick, isPa
 	if isPartnerWinning {
 		// Partner is winning - don't waste a trump, but we must play one
 		// This situation means we're void in the lead suit and only have trumps
-		return s.lowestCard(trumps)
+		return s.because("void in the led suit, so trump low under partner", s.lowestTrump(trumps, trump))
 	}
 
 	// Opponent is winning - trump with lowest trump that wins
@@ -145,21 +291,21 @@ func (s *PlayStrategy) playTrump(trumps []engine.Card, trick *engine.Trick, isPa
 	}
 
 	if len(beaters) > 0 {
-		return s.lowestCard(beaters)
+		return s.because("void in the led suit, so take it with the lowest trump that wins", s.lowestTrump(beaters, trump))
 	}
 
 	// All our trumps lose to the current winner (e.g., they already trumped high)
 	// Play our lowest trump
-	return s.lowestCard(trumps)
+	return s.because("no trump of ours wins, so spend the lowest", s.lowestTrump(trumps, trump))
 }
 
 // selectDiscard chooses which card to throw away
-func (s *PlayStrategy) selectDiscard(options []engine.Card) engine.Card {
+func (s *PlayStrategy) selectDiscard(options []engine.Card, trump engine.Suit) engine.Card {
 	if len(options) == 0 {
 		return engine.Card{}
 	}
 	// Discard our lowest card
-	return s.lowestCard(options)
+	return s.lowestCard(options, trump)
 }
 
 // beats returns true if card a beats card b
@@ -202,17 +348,18 @@ func (s *PlayStrategy) highestCard(cards []engine.Card, trump engine.Suit) engin
 	return best
 }
 
-// lowestCard finds the lowest value card
-func (s *PlayStrategy) lowestCard(cards []engine.Card) engine.Card {
+// lowestCard finds the lowest value card, ranking trumps by trump value so
+// the bowers and the Benny never pass for low cards
+func (s *PlayStrategy) lowestCard(cards []engine.Card, trump engine.Suit) engine.Card {
 	if len(cards) == 0 {
 		return engine.Card{}
 	}
 
 	worst := cards[0]
-	worstValue := worst.OffSuitValue()
+	worstValue := s.cardValue(worst, trump)
 
 	for _, card := range cards[1:] {
-		value := card.OffSuitValue()
+		value := s.cardValue(card, trump)
 		if value < worstValue {
 			worst = card
 			worstValue = value
@@ -222,6 +369,17 @@ func (s *PlayStrategy) lowestCard(cards []engine.Card) engine.Card {
 	return worst
 }
 
+// lowestTrump finds the lowest trump by trump rank
+func (s *PlayStrategy) lowestTrump(trumps []engine.Card, trump engine.Suit) engine.Card {
+	low := trumps[0]
+	for _, card := range trumps[1:] {
+		if card.TrumpValue(trump) < low.TrumpValue(trump) {
+			low = card
+		}
+	}
+	return low
+}
+
 // cardValue returns a comparable value for a card
 func (s *PlayStrategy) cardValue(card engine.Card, trump engine.Suit) int {
 	if card.IsTrump(trump) {
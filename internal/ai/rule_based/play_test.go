@@ -1,6 +1,7 @@
 package rule_based
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/BrandonDedolph/euchre/internal/engine"
@@ -46,6 +47,9 @@ func TestPlayStrategy_SelectPlay_LeadingAce(t *testing.T) {
 	if play.Suit != engine.Spades || play.Rank != engine.Ace {
 		t.Errorf("Should lead off-suit ace, got %s", play)
 	}
+	if got := strategy.Reason(); got != "cash an off-suit ace" {
+		t.Errorf("reason = %q, want the off-suit ace rule", got)
+	}
 }
 
 func TestPlayStrategy_SelectPlay_Following_PartnerWinning(t *testing.T) {
@@ -209,3 +213,214 @@ func TestPlayStrategy_Discard(t *testing.T) {
 		t.Errorf("Should discard low card, got %s", play)
 	}
 }
+
+// TestPlayStrategy_BennyIsNeverTheLowTrump verifies the Benny, which has no
+// rank of its own, is kept back as the highest trump rather than played as
+// the lowest.
+func TestPlayStrategy_BennyIsNeverTheLowTrump(t *testing.T) {
+	trump := engine.Hearts
+	benny := engine.NewCard(engine.NoSuit, engine.Joker)
+	low := engine.Card{Suit: engine.Hearts, Rank: engine.Nine}
+	hand := []engine.Card{benny, low}
+
+	strategy := NewPlayStrategy()
+
+	// Partner is winning a spade we're void in.
+	trick := engine.NewTrick(trump)
+	trick.Play(1, engine.Card{Suit: engine.Spades, Rank: engine.Nine})
+	trick.Play(2, engine.Card{Suit: engine.Spades, Rank: engine.Ace})
+	if play := strategy.SelectPlay(hand, trick, 3, trump); play != low {
+		t.Errorf("trumping under partner should play the 9, got %s", play)
+	}
+
+	// An opponent is winning, and either trump takes it.
+	trick = engine.NewTrick(trump)
+	trick.Play(2, engine.Card{Suit: engine.Spades, Rank: engine.Ace})
+	if play := strategy.SelectPlay(hand, trick, 3, trump); play != low {
+		t.Errorf("ruffing should take it with the 9, got %s", play)
+	}
+}
+
+// TestPlayStrategy_LeftBowerIsNotTheLowTrump verifies the left bower ranks
+// above the queen of trump, not as a plain jack below it.
+func TestPlayStrategy_LeftBowerIsNotTheLowTrump(t *testing.T) {
+	trump := engine.Hearts
+	left := engine.Card{Suit: engine.Diamonds, Rank: engine.Jack}
+	queen := engine.Card{Suit: engine.Hearts, Rank: engine.Queen}
+
+	// Partner's right bower is winning the trump lead.
+	trick := engine.NewTrick(trump)
+	trick.Play(1, engine.Card{Suit: engine.Hearts, Rank: engine.Jack})
+	trick.Play(2, engine.Card{Suit: engine.Hearts, Rank: engine.Nine})
+
+	strategy := NewPlayStrategy()
+	if play := strategy.SelectPlay([]engine.Card{left, queen}, trick, 3, trump); play != queen {
+		t.Errorf("following under partner should play the queen, got %s", play)
+	}
+}
+
+// TestPlayStrategy_LowTrumpReasonsMatchTheCard verifies the reasons shown in
+// the coach and AI explanations describe the card actually played when the
+// hand holds the Benny and a bower.
+func TestPlayStrategy_LowTrumpReasonsMatchTheCard(t *testing.T) {
+	trump := engine.Hearts
+	benny := engine.NewCard(engine.NoSuit, engine.Joker)
+	left := engine.Card{Suit: engine.Diamonds, Rank: engine.Jack}
+	queen := engine.Card{Suit: engine.Hearts, Rank: engine.Queen}
+	hand := []engine.Card{benny, left, queen}
+
+	strategy := NewPlayStrategy()
+	memory := NewCardMemory(trump)
+	memory.maker = 1
+	strategy.Remember(memory)
+
+	tests := []struct {
+		trick  []engine.PlayedCard
+		reason string
+	}{
+		{nil, "only trump left, so lead the lowest"},
+		{[]engine.PlayedCard{{Player: 1, Card: engine.Card{Suit: engine.Spades, Rank: engine.Ace}}}, "void in the led suit, so take it with the lowest trump that wins"},
+		{[]engine.PlayedCard{{Player: 1, Card: engine.Card{Suit: engine.Hearts, Rank: engine.Nine}}}, "the lowest card that beats the winner"},
+	}
+	for _, tt := range tests {
+		trick := engine.NewTrick(trump)
+		for _, pc := range tt.trick {
+			trick.Play(pc.Player, pc.Card)
+		}
+		play := strategy.SelectPlay(hand, trick, 2, trump)
+		if play != queen || strategy.Reason() != tt.reason {
+			t.Errorf("after %v played %s because %q, want %s because %q", tt.trick, play, strategy.Reason(), queen, tt.reason)
+		}
+	}
+}
+
+func TestPlayStrategy_Memory_LeadsBossKing(t *testing.T) {
+	strategy := NewPlayStrategy()
+	trump := engine.Hearts
+	strategy.Remember(memoryAfter(trump, []engine.PlayedCard{
+		{Player: 1, Card: engine.Card{Suit: engine.Clubs, Rank: engine.Ace}},
+		{Player: 2, Card: engine.Card{Suit: engine.Clubs, Rank: engine.Nine}},
+		{Player: 3, Card: engine.Card{Suit: engine.Clubs, Rank: engine.Ten}},
+		{Player: 0, Card: engine.Card{Suit: engine.Clubs, Rank: engine.Queen}},
+	}))
+
+	hand := []engine.Card{
+		{Suit: engine.Hearts, Rank: engine.Nine},
+		{Suit: engine.Clubs, Rank: engine.King},
+		{Suit: engine.Diamonds, Rank: engine.Nine},
+		{Suit: engine.Spades, Rank: engine.Ten},
+	}
+
+	play := strategy.SelectPlay(hand, engine.NewTrick(trump), 0, trump)
+	if play.Suit != engine.Clubs || play.Rank != engine.King {
+		t.Errorf("the king of clubs is boss with the ace gone and should be led, got %s", play)
+	}
+}
+
+func TestPlayStrategy_Memory_AvoidsLeadingIntoAVoid(t *testing.T) {
+	strategy := NewPlayStrategy()
+	trump := engine.Hearts
+	// Player 1 (on our left) trumped a diamond.
+	strategy.Remember(memoryAfter(trump, []engine.PlayedCard{
+		{Player: 0, Card: engine.Card{Suit: engine.Diamonds, Rank: engine.King}},
+		{Player: 1, Card: engine.Card{Suit: engine.Hearts, Rank: engine.Nine}},
+		{Player: 2, Card: engine.Card{Suit: engine.Diamonds, Rank: engine.Ten}},
+		{Player: 3, Card: engine.Card{Suit: engine.Diamonds, Rank: engine.Queen}},
+	}))
+
+	hand := []engine.Card{
+		{Suit: engine.Diamonds, Rank: engine.Nine},
+		{Suit: engine.Spades, Rank: engine.Queen},
+		{Suit: engine.Clubs, Rank: engine.Ten},
+		{Suit: engine.Hearts, Rank: engine.Ten},
+	}
+
+	play := strategy.SelectPlay(hand, engine.NewTrick(trump), 0, trump)
+	if play.Suit == engine.Diamonds {
+		t.Errorf("should not lead diamonds into a known void, got %s", play)
+	}
+}
+
+func TestPlayStrategy_Memory_DoesNotTrumpPartnersGoodAce(t *testing.T) {
+	trump := engine.Hearts
+	hand := []engine.Card{
+		{Suit: engine.Hearts, Rank: engine.Nine},
+		{Suit: engine.Clubs, Rank: engine.Nine},
+		{Suit: engine.Diamonds, Rank: engine.Ten},
+	}
+	trick := engine.NewTrick(trump)
+	trick.Play(1, engine.Card{Suit: engine.Spades, Rank: engine.Nine})
+	trick.Play(2, engine.Card{Suit: engine.Spades, Rank: engine.Ace}) // Partner
+
+	strategy := NewPlayStrategy()
+	strategy.Remember(NewCardMemory(trump))
+	play := strategy.SelectPlay(hand, trick, 0, trump)
+	if play.IsTrump(trump) {
+		t.Errorf("partner's ace is boss and player 3 hasn't shown out, so discard; got %s", play)
+	}
+}
+
+func TestPlayStrategy_Memory_LeadsTrumpBackToPartnersCall(t *testing.T) {
+	strategy := NewPlayStrategy()
+	trump := engine.Hearts
+	memory := NewCardMemory(trump)
+	memory.maker = 2 // Partner called hearts
+	strategy.Remember(memory)
+
+	hand := []engine.Card{
+		{Suit: engine.Hearts, Rank: engine.Ten},
+		{Suit: engine.Hearts, Rank: engine.Queen},
+		{Suit: engine.Spades, Rank: engine.Ace},
+		{Suit: engine.Clubs, Rank: engine.Nine},
+	}
+
+	play := strategy.SelectPlay(hand, engine.NewTrick(trump), 0, trump)
+	if play.Suit != engine.Hearts || play.Rank != engine.Ten {
+		t.Errorf("should lead a low trump back to partner's call, got %s", play)
+	}
+}
+
+func TestPlayStrategy_Memory_KeepsTheBowerBack(t *testing.T) {
+	trump := engine.Hearts
+	left := engine.Card{Suit: engine.Diamonds, Rank: engine.Jack}
+	queen := engine.Card{Suit: engine.Hearts, Rank: engine.Queen}
+	hand := []engine.Card{left, queen}
+
+	// The opponents called hearts, so only trump is left to lead.
+	strategy := NewPlayStrategy()
+	memory := NewCardMemory(trump)
+	memory.maker = 1
+	strategy.Remember(memory)
+	if play := strategy.SelectPlay(hand, engine.NewTrick(trump), 0, trump); play != queen {
+		t.Errorf("leading from only trump should play the queen, got %s", play)
+	}
+	if got := strategy.Reason(); got != "only trump left, so lead the lowest" {
+		t.Errorf("reason = %q, want the lowest-trump lead", got)
+	}
+
+	// An opponent's ace is winning a spade we're void in.
+	trick := engine.NewTrick(trump)
+	trick.Play(1, engine.Card{Suit: engine.Spades, Rank: engine.Ace})
+	if play := strategy.SelectPlay(hand, trick, 2, trump); play != queen {
+		t.Errorf("ruffing should take it with the queen, got %s", play)
+	}
+}
+
+func TestBeginnerPlayIsAlwaysLegal(t *testing.T) {
+	trump := engine.Hearts
+	rng := rand.New(rand.NewSource(1))
+	trick := engine.NewTrick(trump)
+	trick.Play(1, engine.Card{Suit: engine.Spades, Rank: engine.King})
+
+	hand := []engine.Card{
+		{Suit: engine.Spades, Rank: engine.Nine},
+		{Suit: engine.Spades, Rank: engine.Ace},
+		{Suit: engine.Hearts, Rank: engine.Jack},
+		{Suit: engine.Clubs, Rank: engine.Ace},
+	}
+	for i := 0; i < 100; i++ {
+		if err := engine.ValidatePlay(engine.NewHandWith(hand), beginnerPlay(hand, trick, trump, rng), trick); err != nil {
+			t.Fatalf("beginner play was illegal: %v", err)
+		}
+	}
+}
@@ -2,15 +2,20 @@ package ai
 
 import "github.com/BrandonDedolph/euchre/internal/engine"
 
-// Strategy defines the interface for AI decision-making strategies
+// Strategy defines the interface for AI decision-making strategies. Wrap one
+// in a StrategyPlayer to seat it at the table.
 type Strategy interface {
-	// EvaluateHandForBid scores a hand for bidding purposes (0-100)
+	// EvaluateHandForBid scores a hand for bidding purposes (0-100). round is
+	// the bidding round and position the bidder's seat counted clockwise from
+	// the dealer (0 is the dealer).
 	EvaluateHandForBid(hand []engine.Card, turnedCard engine.Card, round int, position int) int
 
 	// ShouldGoAlone decides if the player should go alone
 	ShouldGoAlone(hand []engine.Card, trump engine.Suit) bool
 
-	// ChooseTrump selects the best trump suit for round 2 bidding
+	// ChooseTrump selects the best trump suit for round 2 bidding, and whether
+	// the hand is strong enough to call it. The suit is returned either way so
+	// a dealer stuck under stick-the-dealer has something to call.
 	ChooseTrump(hand []engine.Card, excludeSuit engine.Suit) (engine.Suit, bool)
 
 	// SelectPlay chooses the best card to play
@@ -22,3 +27,26 @@ type Strategy interface {
 	// SelectLead chooses the best card to lead
 	SelectLead(hand []engine.Card, trump engine.Suit, state *engine.GameState) engine.Card
 }
+
+// DefaultBidThreshold is the EvaluateHandForBid score at which a strategy
+// orders up, unless it implements BidThresholder.
+const DefaultBidThreshold = 55
+
+// BidThresholder is implemented by strategies that choose their own
+// EvaluateHandForBid score for ordering up.
+type BidThresholder interface {
+	BidThreshold() int
+}
+
+// BidChooser is implemented by strategies that can decide a bid from the
+// whole game state rather than a hand strength score. ok is false to leave
+// the bid to EvaluateHandForBid and the threshold.
+type BidChooser interface {
+	ChooseBid(state *engine.GameState, round int) (decision engine.BidDecision, reason string, ok bool)
+}
+
+// DefendAloneStrategy is implemented by strategies that decide whether to
+// defend alone against a lone maker. Strategies without it always decline.
+type DefendAloneStrategy interface {
+	ShouldDefendAlone(hand []engine.Card, trump engine.Suit) bool
+}
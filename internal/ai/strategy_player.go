@@ -0,0 +1,189 @@
+package ai
+
+import "github.com/BrandonDedolph/euchre/internal/engine"
+
+// StrategyPlayer is a Player that delegates every decision to a Strategy.
+// It turns the strategy's scores and choices into legal bids and plays.
+type StrategyPlayer struct {
+	name      string
+	playerIdx int
+	strategy  Strategy
+	last      *Explanation // rationale for the most recent decision
+}
+
+var (
+	_ Player    = (*StrategyPlayer)(nil)
+	_ Explainer = (*StrategyPlayer)(nil)
+)
+
+// NewStrategyPlayer seats strategy at playerIdx under the given name
+func NewStrategyPlayer(name string, playerIdx int, strategy Strategy) *StrategyPlayer {
+	return &StrategyPlayer{name: name, playerIdx: playerIdx, strategy: strategy}
+}
+
+// Name returns the AI's display name
+func (p *StrategyPlayer) Name() string {
+	return p.name
+}
+
+// Strategy returns the strategy making this player's decisions
+func (p *StrategyPlayer) Strategy() Strategy {
+	return p.strategy
+}
+
+// Explain returns the rationale for the player's most recent decision
+func (p *StrategyPlayer) Explain() (Explanation, bool) {
+	if p.last == nil {
+		return Explanation{}, false
+	}
+	return *p.last, true
+}
+
+// reason returns the strategy's reason for its last card, if it gives one
+func (p *StrategyPlayer) reason() string {
+	if r, ok := p.strategy.(Reasoner); ok {
+		return r.Reason()
+	}
+	return ""
+}
+
+// bidThreshold returns the score at which the strategy orders up
+func (p *StrategyPlayer) bidThreshold() int {
+	if t, ok := p.strategy.(BidThresholder); ok {
+		return t.BidThreshold()
+	}
+	return DefaultBidThreshold
+}
+
+// DecideBid decides what to do during bidding
+func (p *StrategyPlayer) DecideBid(state *engine.GameState, bidRound int) engine.BidDecision {
+	hand := state.Hand(p.playerIdx)
+	turnedCard := state.TurnedCard()
+	dealer := state.Dealer()
+	isDealer := p.playerIdx == dealer
+	position := (p.playerIdx - dealer + state.NumPlayers()) % state.NumPlayers()
+	// A turned-up Benny is the dealer's whatever they call, so they judge
+	// every suit with it in hand.
+	if isDealer && turnedCard.IsJoker() {
+		hand = append(hand, turnedCard)
+	}
+	if c, ok := p.strategy.(BidChooser); ok {
+		if decision, reason, ok := c.ChooseBid(state, bidRound); ok {
+			p.last = &Explanation{Decision: DescribeBid(decision), Trump: turnedCard.Suit, Reason: reason}
+			if !decision.Pass && !decision.OrderUp {
+				p.last.Trump = decision.CallSuit
+			}
+			return decision
+		}
+	}
+	why := &Explanation{
+		Trump:     turnedCard.Suit,
+		Strength:  p.strategy.EvaluateHandForBid(hand, turnedCard, bidRound, position),
+		Threshold: p.bidThreshold(),
+	}
+	p.last = why
+
+	if bidRound == 1 {
+		if why.Strength < why.Threshold {
+			why.Decision, why.Reason = "pass", "too weak to order up"
+			return engine.BidDecision{Pass: true}
+		}
+		// The dealer judges going alone on the hand after picking up.
+		if isDealer {
+			hand = append(hand, turnedCard)
+		}
+		alone := p.strategy.ShouldGoAlone(hand, turnedCard.Suit)
+		why.Decision, why.Reason = "order up", "strong enough to order up"
+		if alone {
+			why.Decision, why.Reason = "order up alone", "strong enough to take every trick without partner"
+		}
+		return engine.BidDecision{OrderUp: true, Alone: alone}
+	}
+
+	suit, call := p.strategy.ChooseTrump(hand, turnedCard.Suit)
+	why.Trump = suit
+	// Under stick-the-dealer, or with the Benny turned up, the dealer may not
+	// pass and must name a legal suit, whatever the strategy thinks of the hand.
+	stuck := isDealer && state.MustCall()
+	if !call && !stuck {
+		why.Decision, why.Reason = "pass", "no suit is strong enough to call"
+		return engine.BidDecision{Pass: true}
+	}
+	if suit == engine.NoSuit || suit == turnedCard.Suit {
+		for _, s := range []engine.Suit{engine.Clubs, engine.Diamonds, engine.Hearts, engine.Spades} {
+			if s != turnedCard.Suit {
+				suit = s
+				break
+			}
+		}
+	}
+	alone := p.strategy.ShouldGoAlone(hand, suit)
+	why.Trump = suit
+	why.Decision = "call " + suit.String()
+	why.Reason = "the strongest suit that can be called"
+	if !call {
+		why.Reason = "stuck as dealer, so calling the strongest suit"
+	}
+	if alone {
+		why.Decision += " alone"
+		why.Reason += ", and strong enough to go alone"
+	}
+	return engine.BidDecision{CallSuit: suit, Alone: alone}
+}
+
+// DecidePlay chooses which card to play
+func (p *StrategyPlayer) DecidePlay(state *engine.GameState) engine.Card {
+	round := state.Round()
+	if round == nil {
+		return engine.Card{}
+	}
+	hand := state.Hand(p.playerIdx)
+	trump := state.Trump()
+
+	trick := engine.NewTrick(trump)
+	for _, pc := range round.CurrentTrick() {
+		trick.Play(pc.Player, pc.Card)
+	}
+	var card engine.Card
+	if trick.Size() == 0 {
+		card = p.strategy.SelectLead(hand, trump, state)
+	} else {
+		card = p.strategy.SelectPlay(hand, trick, state)
+	}
+	p.last = &Explanation{
+		Decision:   "play " + card.String(),
+		Trump:      trump,
+		Candidates: engine.LegalPlays(engine.NewHandWith(hand), trick),
+		Card:       card,
+		Reason:     p.reason(),
+	}
+	return card
+}
+
+// DecideDiscard chooses which card to discard when dealer picks up
+func (p *StrategyPlayer) DecideDiscard(state *engine.GameState, hand []engine.Card) engine.Card {
+	card := p.strategy.SelectDiscard(hand, state.Trump())
+	p.last = &Explanation{
+		Decision:   "discard " + card.String(),
+		Trump:      state.Trump(),
+		Candidates: append([]engine.Card(nil), hand...),
+		Card:       card,
+		Reason:     p.reason(),
+	}
+	return card
+}
+
+// DecideDefendAlone decides whether to declare a lone defense against a lone
+// maker, if the strategy has an opinion
+func (p *StrategyPlayer) DecideDefendAlone(state *engine.GameState) bool {
+	p.last = &Explanation{Decision: "decline", Trump: state.Trump(), Reason: "the strategy never defends alone"}
+	if d, ok := p.strategy.(DefendAloneStrategy); ok {
+		defend := d.ShouldDefendAlone(state.Hand(p.playerIdx), state.Trump())
+		p.last.Reason = "not strong enough to take 3 tricks single-handed"
+		if defend {
+			p.last.Decision, p.last.Reason = "defend alone", "strong enough to take 3 tricks single-handed"
+		}
+		return defend
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package ai
+
+import (
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// passingStrategy never wants to bid and plays its first card.
+type passingStrategy struct{}
+
+func (passingStrategy) EvaluateHandForBid([]engine.Card, engine.Card, int, int) int { return 0 }
+func (passingStrategy) ShouldGoAlone([]engine.Card, engine.Suit) bool               { return false }
+func (passingStrategy) ChooseTrump([]engine.Card, engine.Suit) (engine.Suit, bool) {
+	return engine.NoSuit, false
+}
+func (passingStrategy) SelectPlay(hand []engine.Card, _ *engine.Trick, _ *engine.GameState) engine.Card {
+	return hand[0]
+}
+func (passingStrategy) SelectDiscard(hand []engine.Card, _ engine.Suit) engine.Card { return hand[0] }
+func (passingStrategy) SelectLead(hand []engine.Card, _ engine.Suit, _ *engine.GameState) engine.Card {
+	return hand[0]
+}
+
+func TestStrategyPlayerCallsForAStuckDealer(t *testing.T) {
+	config := engine.DefaultGameConfig()
+	config.Rules = engine.Rules{StickTheDealer: true}
+	game := engine.NewGame(config)
+	game.StartRound()
+
+	// Everyone passes twice round to the dealer.
+	for game.CurrentPlayer() != game.Dealer() || game.Phase() != engine.PhaseBidRound2 {
+		if err := game.ApplyAction(engine.PassAction{PlayerIdx: game.CurrentPlayer()}); err != nil {
+			t.Fatalf("pass failed: %v", err)
+		}
+	}
+
+	p := NewStrategyPlayer("Dealer", game.Dealer(), passingStrategy{})
+	decision := p.DecideBid(engine.NewGameState(game), 2)
+	action := engine.CallTrumpAction{PlayerIdx: game.Dealer(), Suit: decision.CallSuit, Alone: decision.Alone}
+	if decision.Pass {
+		t.Fatal("a stuck dealer must call even when the strategy would pass")
+	}
+	if err := game.ApplyAction(action); err != nil {
+		t.Fatalf("engine rejected the stuck dealer's call of %v: %v", decision.CallSuit, err)
+	}
+}
+
+func TestNewPlayerLooksUpRegisteredStrategies(t *testing.T) {
+	Register("passing", func(name string, playerIdx int, _ Difficulty) (Player, error) {
+		return NewStrategyPlayer(name, playerIdx, passingStrategy{}), nil
+	})
+	defer delete(DefaultRegistry.factories, "passing")
+
+	p, err := NewPlayer("passing", "Alice", 0, DifficultyMedium)
+	if err != nil {
+		t.Fatalf("NewPlayer failed: %v", err)
+	}
+	if p.Name() != "Alice" {
+		t.Errorf("player name = %q, want Alice", p.Name())
+	}
+	if _, err := NewPlayer("no-such-strategy", "Bob", 1, DifficultyMedium); err == nil {
+		t.Error("an unknown strategy should be an error")
+	}
+}
+
+func TestStrategyPlayerExplainsItsDecisions(t *testing.T) {
+	game := engine.NewGame(engine.DefaultGameConfig())
+	game.StartRound()
+	seat := game.CurrentPlayer()
+	p := NewStrategyPlayer("Alice", seat, passingStrategy{})
+
+	if _, ok := p.Explain(); ok {
+		t.Fatal("no explanation should exist before the first decision")
+	}
+	p.DecideBid(engine.NewGameState(game), 1)
+	why, ok := p.Explain()
+	if !ok || why.Decision != "pass" {
+		t.Fatalf("explanation = %+v, want a pass", why)
+	}
+	if !why.Scored() || why.Strength != 0 || why.Threshold != DefaultBidThreshold {
+		t.Errorf("bid explanation should carry the strength and threshold: %+v", why)
+	}
+	if why.Trump != game.TurnedCard().Suit {
+		t.Errorf("round 1 bid judged for %v, want the turned suit %v", why.Trump, game.TurnedCard().Suit)
+	}
+
+	// Play out the bidding to the first lead and explain it.
+	for game.Phase() != engine.PhasePlay {
+		if err := game.ApplyAction(game.LegalActions()[len(game.LegalActions())-1]); err != nil {
+			t.Fatalf("apply failed: %v", err)
+		}
+	}
+	lead := NewStrategyPlayer("Lead", game.CurrentPlayer(), passingStrategy{})
+	card := lead.DecidePlay(engine.NewGameState(game))
+	why, _ = lead.Explain()
+	if why.Card != card || why.Scored() || len(why.Candidates) != len(game.Hand(game.CurrentPlayer())) {
+		t.Errorf("lead explanation = %+v for %v", why, card)
+	}
+}
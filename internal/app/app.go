@@ -1,6 +1,8 @@
 package app
 
 import (
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/engine"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -15,6 +17,7 @@ const (
 	ScreenLearningJourney
 	ScreenQuickReference
 	ScreenSettings
+	ScreenReplay
 )
 
 // App is the root Bubble Tea model
@@ -24,6 +27,13 @@ type App struct {
 	width         int
 	height        int
 	quitting      bool
+
+	// seed, when non-zero, is applied to games that don't carry their own
+	// seed so a reported game can be replayed exactly (euchre play --seed).
+	seed int64
+	// strategy, when set, picks the opponents' AI strategy for games that
+	// don't name one (euchre play --strategy).
+	strategy string
 }
 
 // New creates a new App
@@ -39,6 +49,30 @@ func New() *App {
 	return app
 }
 
+// NewWithSeed creates a new App whose games are dealt from the given seed.
+func NewWithSeed(seed int64) *App {
+	app := New()
+	app.seed = seed
+	return app
+}
+
+// NewWithOptions creates a new App whose games are dealt from seed and whose
+// opponents use the named AI strategy. Zero values keep the defaults.
+func NewWithOptions(seed int64, strategy string) *App {
+	app := NewWithSeed(seed)
+	app.strategy = strategy
+	return app
+}
+
+// NewWithReplay creates a new App that opens straight into the replay viewer
+// for the given hands (euchre replay).
+func NewWithReplay(hands []engine.HandHistory) *App {
+	app := New()
+	app.currentScreen = ScreenReplay
+	app.screenModels[ScreenReplay] = NewReplay(hands)
+	return app
+}
+
 // Init implements tea.Model
 func (a *App) Init() tea.Cmd {
 	return nil
@@ -50,6 +84,12 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "ctrl+c":
+			// Screens holding unsaved progress (a game in play) get a chance
+			// to persist it before the program exits.
+			if saver, ok := a.screenModels[a.currentScreen].(interface{ saveOnExit() }); ok {
+				saver.saveOnExit()
+			}
+			a.closeGame()
 			a.quitting = true
 			return a, tea.Quit
 		}
@@ -62,6 +102,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return a.navigate(msg.Screen, msg.Data)
 
 	case QuitMsg:
+		a.closeGame()
 		a.quitting = true
 		return a, tea.Quit
 	}
@@ -96,17 +137,35 @@ func (a *App) navigate(screen Screen, data interface{}) (*App, tea.Cmd) {
 	case ScreenMainMenu:
 		a.screenModels[screen] = NewMainMenu()
 	case ScreenGameSetup:
-		a.screenModels[screen] = NewGameSetup()
+		setup := NewGameSetup()
+		if a.strategy != "" {
+			setup.setStrategy(a.strategy)
+		}
+		a.screenModels[screen] = setup
 	case ScreenGamePlay:
-		if settings, ok := data.(GameSettings); ok {
-			a.screenModels[screen] = NewGamePlayWithSettings(settings)
-		} else {
-			a.screenModels[screen] = NewGamePlay()
+		a.closeGame()
+		if save, ok := data.(*SavedGame); ok {
+			a.screenModels[screen] = NewGamePlayFromSave(save)
+			break
+		}
+		settings, ok := data.(GameSettings)
+		if !ok {
+			settings = GameSettings{Variant: "Standard", Difficulty: ai.DifficultyMedium}
+		}
+		if settings.Seed == 0 {
+			settings.Seed = a.seed
 		}
+		if settings.Strategy == "" {
+			settings.Strategy = a.strategy
+		}
+		a.screenModels[screen] = NewGamePlayWithSettings(settings)
 	case ScreenQuickReference:
 		a.screenModels[screen] = NewQuickReference()
 	case ScreenLearningJourney:
 		a.screenModels[screen] = NewLearningJourney()
+	case ScreenReplay:
+		hands, _ := data.([]engine.HandHistory)
+		a.screenModels[screen] = NewReplay(hands)
 	}
 
 	// Pass current window size to the new screen
@@ -129,6 +188,14 @@ func (a *App) navigate(screen Screen, data interface{}) (*App, tea.Cmd) {
 	return a, nil
 }
 
+// closeGame releases the AI players of the game screen, stopping any
+// external bot processes
+func (a *App) closeGame() {
+	if gp, ok := a.screenModels[ScreenGamePlay].(*GamePlay); ok {
+		ai.ClosePlayers(gp.aiPlayers)
+	}
+}
+
 // NavigateMsg is sent to navigate between screens
 type NavigateMsg struct {
 	Screen Screen
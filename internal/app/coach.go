@@ -3,6 +3,7 @@ package app
 import (
 	"fmt"
 
+	"github.com/BrandonDedolph/euchre/internal/ai"
 	"github.com/BrandonDedolph/euchre/internal/engine"
 	"github.com/BrandonDedolph/euchre/internal/ui/theme"
 	"github.com/charmbracelet/lipgloss"
@@ -49,8 +50,14 @@ func (g *GamePlay) tutorBox() (title, body string, ok bool) {
 	}
 
 	switch {
+	case g.isShuffling && g.bennyInPlay():
+		return "Dealing", "Shuffling the 25-card deck — 9 through Ace in each suit, plus the Benny (joker), the highest trump of all.", true
+	case g.isShuffling && g.lowestRank() != engine.Nine:
+		return "Dealing", fmt.Sprintf("Shuffling the %d-card deck — %s through Ace in each suit. The cards below the 9 rank lowest, in trump and out.", len(g.game.Round().Pack()), g.lowestRank()), true
 	case g.isShuffling:
 		return "Dealing", "Shuffling the 24-card Euchre deck — only 9, 10, Jack, Queen, King, Ace in each suit.", true
+	case g.isDealing && g.game.Rules().Stacks > 0:
+		return "Dealing", fmt.Sprintf("Two-handed Euchre deals each player a row of %d face-down cards with a face-up card on each, then a hand of 5. The face-up cards are played like the rest of your hand, and each one turns up the card beneath it.", g.game.Rules().Stacks), true
 	case g.isDealing:
 		return "Dealing", "Euchre deals in packets — 2s and 3s — until everyone has 5 cards. The next card is turned face-up to start the bidding.", true
 	case g.waitingForTrickAck && g.completedTrick != nil:
@@ -88,6 +95,33 @@ func (g *GamePlay) tutorBox() (title, body string, ok bool) {
 	return g.opponentNarration()
 }
 
+// bennyInPlay reports whether the deck includes the Benny, as in British
+// Euchre
+func (g *GamePlay) bennyInPlay() bool {
+	if r := g.game.Round(); r != nil {
+		for _, c := range r.Pack() {
+			if c.IsJoker() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// lowestRank returns the lowest rank in the deck the round was dealt from:
+// the 9 unless the 7s and 8s (or the 6s) are in play
+func (g *GamePlay) lowestRank() engine.Rank {
+	low := engine.Card{Rank: engine.Nine}
+	if r := g.game.Round(); r != nil {
+		for _, c := range r.Pack() {
+			if !c.IsJoker() && c.OffSuitValue() < low.OffSuitValue() {
+				low = c
+			}
+		}
+	}
+	return low.Rank
+}
+
 // trickNarration describes who just won the trick and why, occasionally naming
 // the specific winning card (a bower, a trump over the led suit) instead of the
 // generic rule.
@@ -135,8 +169,14 @@ func (g *GamePlay) opponentNarration() (title, body string, ok bool) {
 	case engine.PhaseBidRound1:
 		return "Bidding", fmt.Sprintf("%s is deciding whether to order up the %s and make it trump.", name, g.game.TurnedCard()), true
 	case engine.PhaseBidRound2:
+		if g.game.TurnedCard().IsJoker() {
+			return "Bidding", fmt.Sprintf("The Benny was turned up, so %s, the dealer, must name trump and take it.", name), true
+		}
 		return "Bidding", fmt.Sprintf("The turn-up was passed. %s may now name a different trump suit — or pass.", name), true
 	case engine.PhaseDiscard:
+		if round := g.game.Round(); round != nil && round.Exchanged() {
+			return "Discard", fmt.Sprintf("%s is going alone, took their partners' best cards and is pitching as many back.", name), true
+		}
 		return "Discard", fmt.Sprintf("%s took the turn card into hand and is pitching one card back.", name), true
 	case engine.PhaseDefendAlone:
 		return "Defend alone?", fmt.Sprintf("%s is deciding whether to defend alone against the lone hand.", name), true
@@ -178,6 +218,16 @@ func (g *GamePlay) coachTip() string {
 	return ""
 }
 
+// coachExplanation returns the coach's own rationale for the decision it just
+// made, so tips say what the AI actually weighed rather than a guess at it.
+// ok is false when the coach can't explain itself.
+func (g *GamePlay) coachExplanation() (ai.Explanation, bool) {
+	if e, ok := g.coach.(ai.Explainer); ok {
+		return e.Explain()
+	}
+	return ai.Explanation{}, false
+}
+
 // coachWould runs decide against a fresh game state and returns the coach's
 // choice, or the zero Card when there's no coach (non-tutorial). Used to capture
 // the recommendation just before the human commits a move, for grading.
@@ -231,7 +281,7 @@ func (g *GamePlay) coachPickIndex() int {
 	case engine.PhasePlay:
 		pick = g.coach.DecidePlay(engine.NewGameState(g.game))
 	case engine.PhaseDiscard:
-		pick = g.coach.DecideDiscard(engine.NewGameState(g.game), hand)
+		pick = g.coach.DecideDiscard(engine.NewGameState(g.game), g.game.Discardable())
 	default:
 		return -1
 	}
@@ -309,7 +359,7 @@ type trickStanding struct {
 
 // readTrick inspects a trick from the perspective of `seat` (the human). It
 // reports who's winning relative to the human's partner.
-func readTrick(trick *engine.Trick, seat int, trump engine.Suit) trickStanding {
+func readTrick(trick *engine.Trick, seat int, trump engine.Suit, teams int) trickStanding {
 	var st trickStanding
 	if trick == nil || trick.Size() == 0 {
 		return st
@@ -321,7 +371,10 @@ func readTrick(trick *engine.Trick, seat int, trump engine.Suit) trickStanding {
 	st.hasWinner = true
 	st.winnerSeat = trick.Winner()
 	st.winningCard = win
-	st.partnerWinning = engine.IsPartner(seat, st.winnerSeat)
+	if teams <= 0 {
+		teams = 2
+	}
+	st.partnerWinning = seat != st.winnerSeat && engine.TeamOf(seat, teams) == engine.TeamOf(st.winnerSeat, teams)
 	st.winnerIsTrumped = win.IsTrump(trump) && trick.LeadSuit() != trump
 	return st
 }
@@ -380,12 +433,32 @@ func trumpSeen(history []engine.TrickResult, current []engine.PlayedCard, trump
 }
 
 // makerContext describes the human's stake in the round: whether their team
-// made trump, and each team's trick count so far.
+// made trump, and each side's trick count so far.
 type makerContext struct {
 	isMaker      bool
 	myTricks     int
 	theirTricks  int
 	tricksPlayed int
+	teams        int // teams at the table; 0 means the usual two
+	tricks       int // tricks in the round; 0 means the usual five
+}
+
+// toMake is how many tricks the makers need
+func (mc makerContext) toMake() int {
+	tricks := mc.tricks
+	if tricks == 0 {
+		tricks = 5
+	}
+	return tricks/2 + 1
+}
+
+// toEuchre is how many tricks the defenders need to euchre the makers
+func (mc makerContext) toEuchre() int {
+	tricks := mc.tricks
+	if tricks == 0 {
+		tricks = 5
+	}
+	return tricks - mc.toMake() + 1
 }
 
 func (g *GamePlay) makerContext() makerContext {
@@ -394,11 +467,13 @@ func (g *GamePlay) makerContext() makerContext {
 	if round == nil {
 		return mc
 	}
-	myTeam := engine.Team(g.humanPlayer)
+	myTeam := round.Team(g.humanPlayer)
 	mc.isMaker = round.MakerTeam() == myTeam
 	mc.myTricks = round.TeamTricksWon(myTeam)
-	mc.theirTricks = round.TeamTricksWon(1 - myTeam)
 	mc.tricksPlayed = len(round.TrickHistory())
+	mc.theirTricks = mc.tricksPlayed - mc.myTricks
+	mc.teams = round.NumTeams()
+	mc.tricks = round.Rules().TrickCount()
 	return mc
 }
 
@@ -407,20 +482,20 @@ func (g *GamePlay) makerContext() makerContext {
 func (mc makerContext) stakeClue() string {
 	if mc.isMaker {
 		switch {
-		case mc.myTricks >= 3:
+		case mc.myTricks >= mc.toMake():
 			return "You've made it — now press for the march."
-		case mc.myTricks == 2:
+		case mc.myTricks == mc.toMake()-1:
 			return "One more trick makes your bid — take it."
-		case mc.theirTricks == 2:
-			return "Two against you — you must take this or risk the euchre."
+		case mc.theirTricks == mc.toEuchre()-1:
+			return fmt.Sprintf("%d tricks against you — you must take this or risk the euchre.", mc.theirTricks)
 		}
 		return ""
 	}
 	// Defending.
 	switch {
-	case mc.theirTricks == 2:
+	case mc.theirTricks == mc.toMake()-1:
 		return "You're defending — this trick stops the euchre, grab it."
-	case mc.myTricks == 2:
+	case mc.myTricks == mc.toEuchre()-1:
 		return "One more trick and they're euchred — fight for it."
 	}
 	return ""
@@ -452,8 +527,15 @@ func (g *GamePlay) tipBidRound1() string {
 		}
 	}
 
+	verdict := " Coach would " + rec + "."
+	if why, ok := g.coachExplanation(); ok && why.Scored() {
+		verdict = fmt.Sprintf(" Coach rates the hand %d and bids at %d, so would %s.", why.Strength, why.Threshold, rec)
+	} else if ok && why.Reason != "" {
+		verdict = fmt.Sprintf(" Coach would %s: it %s.", rec, why.Reason)
+	}
+
 	seat := g.seatNote(g.game.Dealer())
-	body := strength + " Coach would " + rec + "."
+	body := strength + verdict
 	if dec.Alone {
 		body += " Alone risks 2 to win 4 — worth it only with a near-sure hand."
 	} else if seat != "" {
@@ -466,10 +548,11 @@ func (g *GamePlay) tipBidRound1() string {
 // relative to the dealer (left of dealer hands the dealer the turn-up;
 // dealer's partner should be sure they want the dealer to take it).
 func (g *GamePlay) seatNote(dealer int) string {
-	switch (g.humanPlayer - dealer + 4) % 4 {
-	case 1:
+	n := g.game.NumPlayers()
+	switch {
+	case (g.humanPlayer-dealer+n)%n == 1:
 		return "Left of the dealer, ordering hands them the turn-up — bid only on real strength."
-	case 2:
+	case g.humanPlayer != dealer && g.game.Team(g.humanPlayer) == g.game.Team(dealer):
 		return "You're the dealer's partner; order up only if you want them to take that card."
 	default:
 		return ""
@@ -480,7 +563,16 @@ func (g *GamePlay) seatNote(dealer int) string {
 func (g *GamePlay) tipBidRound2() string {
 	turnedDown := g.game.TurnedCard().Suit
 	dec := g.coach.DecideBid(engine.NewGameState(g.game), 2)
+	why, explained := g.coachExplanation()
+	played := explained && !why.Scored() && why.Reason != ""
+	explained = explained && why.Scored()
 	if dec.Pass {
+		if explained {
+			return fmt.Sprintf("Your best suit rates %d and Coach bids at %d, so Coach would pass. (You can't name %s — it was turned down.)", why.Strength, why.Threshold, suitLabel(turnedDown))
+		}
+		if played {
+			return fmt.Sprintf("Coach would pass: it %s. (You can't name %s — it was turned down.)", why.Reason, suitLabel(turnedDown))
+		}
 		return fmt.Sprintf("No suit gives you enough strength, so Coach would pass. (You can't name %s — it was turned down.)", suitLabel(turnedDown))
 	}
 
@@ -493,8 +585,17 @@ func (g *GamePlay) tipBidRound2() string {
 	if bp := s.bowerPhrase(); bp != "" {
 		reason += ", " + bp
 	}
+	if explained {
+		reason += fmt.Sprintf("; rated %d", why.Strength)
+	}
 	reason += ")"
 	body := fmt.Sprintf("Coach would %s — %s.", rec, reason)
+	if played {
+		body = fmt.Sprintf("Coach would %s — %s, and it %s.", rec, reason, why.Reason)
+	}
+	if g.game.TurnedCard().IsJoker() {
+		return "You turned up the Benny, so you must name trump and take it. " + body
+	}
 
 	// Next theory: the same-colour suit as the turned-down card tends to be
 	// strong in round 2 (its bowers were likely passed, so they're still live).
@@ -512,7 +613,10 @@ func (g *GamePlay) tipBidRound2() string {
 func (g *GamePlay) tipDiscard() string {
 	hand := g.game.Hand(g.humanPlayer)
 	trump := g.game.Trump()
-	card := g.coach.DecideDiscard(engine.NewGameState(g.game), hand)
+	card := g.coach.DecideDiscard(engine.NewGameState(g.game), g.game.Discardable())
+	if why, ok := g.coachExplanation(); ok && why.Reason != "" {
+		return explainedTipText("discards", card, why.Reason, "")
+	}
 
 	return discardTipText(card, hand, trump)
 }
@@ -578,7 +682,9 @@ func defendAloneTipText(s handShape) string {
 // tipPlay advises which card to play to the current trick, tailoring the reason
 // to whether you're leading, following suit, trumping in, or pitching. It reads
 // the actual board: who's winning (partner vs opponent), seat position, trump
-// already gone, and whether you're making or defending.
+// already gone, and whether you're making or defending. When the coach can
+// explain itself, its own reason is given instead, so the tip can't drift from
+// what the AI actually does.
 func (g *GamePlay) tipPlay() string {
 	round := g.game.Round()
 	if round == nil {
@@ -589,20 +695,39 @@ func (g *GamePlay) tipPlay() string {
 	card := g.coach.DecidePlay(state)
 	trick := round.Trick()
 	names := g.tableView.PlayerNames
+	leading := trick == nil || trick.Size() == 0
 
-	if trick == nil || trick.Size() == 0 {
+	if why, ok := g.coachExplanation(); ok && why.Reason != "" {
+		verb := "plays"
+		if leading {
+			verb = "leads"
+		}
+		return explainedTipText(verb, card, why.Reason, g.makerContext().stakeClue())
+	}
+
+	if leading {
 		return g.tipLead(card, trump, round)
 	}
 
 	return playTipText(card, trump, trick, g.humanPlayer, names, g.makerContext())
 }
 
+// explainedTipText gives the coach's pick with the reason its AI chose it,
+// plus the stake clue when there is one. Pure for unit testing.
+func explainedTipText(verb string, card engine.Card, reason, stake string) string {
+	msg := fmt.Sprintf("Coach %s %s — %s.", verb, card, reason)
+	if stake != "" {
+		msg += " " + stake
+	}
+	return msg
+}
+
 // playTipText builds the follow/trump/pitch advice for a non-empty trick. Pure
 // so it can be unit-tested against constructed tricks; tipPlay supplies the
 // live game state.
 func playTipText(card engine.Card, trump engine.Suit, trick *engine.Trick, seat int, names []string, mc makerContext) string {
 	led := trick.LeadSuit()
-	st := readTrick(trick, seat, trump)
+	st := readTrick(trick, seat, trump, mc.teams)
 	canWin := st.hasWinner && cardBeats(card, st.winningCard, trump)
 	pos := positionClue(trick.Size(), st.partnerWinning, canWin)
 	stake := mc.stakeClue()
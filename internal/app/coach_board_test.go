@@ -341,3 +341,25 @@ func TestSeatNote_LeftOfDealer(t *testing.T) {
 		t.Errorf("dealer seat should give no caution: %q", got)
 	}
 }
+
+// --- The coach's tips give its AI's own reasons ---
+
+func TestPlayTipGivesTheCoachsOwnReason(t *testing.T) {
+	g := NewGamePlayWithSettings(GameSettings{Variant: "Standard", Tutorial: true, Seed: 7})
+	g.isShuffling, g.isDealing = false, false
+	driveToPlay(t, g, 0)
+	for g.game.CurrentPlayer() != g.humanPlayer {
+		if err := g.game.ApplyAction(g.game.LegalActions()[0]); err != nil {
+			t.Fatalf("apply failed: %v", err)
+		}
+	}
+
+	tip := g.coachTip()
+	why, ok := g.coachExplanation()
+	if !ok || why.Reason == "" {
+		t.Fatalf("the rule-based coach should explain its play, got %+v", why)
+	}
+	if want := why.Card.String() + " — " + why.Reason; !strings.Contains(tip, want) {
+		t.Errorf("tip %q doesn't give the coach's reason %q", tip, want)
+	}
+}
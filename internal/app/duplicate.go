@@ -0,0 +1,104 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/sim"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// duplicateDeal compares the human's result on one deal with the AI's result
+// from the same seat with the same cards. Results are the team's points
+// minus the opponents' on the deal.
+type duplicateDeal struct {
+	Board engine.Board `json:"board"`
+	You   int          `json:"you"`
+	AI    int          `json:"ai"`
+}
+
+// duplicateChallenge is the duplicate challenge: after each deal the human
+// plays, the AI plays the same board from the human's seat, with the same
+// partner and opponents, and the running difference measures the human's
+// play with the luck of the cards taken out.
+type duplicateChallenge struct {
+	strategy   string
+	difficulty ai.Difficulty
+	deals      []duplicateDeal
+}
+
+// duplicateDealMsg reports the AI's replay of a deal
+type duplicateDealMsg struct {
+	deal duplicateDeal
+	err  error
+}
+
+func newDuplicateChallenge(strategy string, difficulty ai.Difficulty, deals []duplicateDeal) *duplicateChallenge {
+	return &duplicateChallenge{strategy: strategy, difficulty: difficulty, deals: deals}
+}
+
+// total returns how many points better than the AI the human has done
+func (d *duplicateChallenge) total() int {
+	total := 0
+	for _, deal := range d.deals {
+		total += deal.You - deal.AI
+	}
+	return total
+}
+
+// compare replays the game's current board in the background with the AI
+// in every seat, for comparison with the human's result on it.
+func (d *duplicateChallenge) compare(game *engine.Game) tea.Cmd {
+	board := game.Board()
+	you := game.Round().Result()
+	config := engine.DefaultGameConfig()
+	config.NumPlayers = game.NumPlayers()
+	config.DeckConfig = game.DeckConfig()
+	config.Rules = game.Round().Rules()
+	teams := game.NumTeams()
+	return func() tea.Msg {
+		// A strategy that won't start fails the comparison rather than
+		// quietly replaying the deal against a different opponent.
+		players := make([]ai.Player, config.NumPlayers)
+		defer ai.ClosePlayers(players)
+		for i := range players {
+			p, err := ai.NewPlayer(d.strategy, ai.PlayerNames[i], i, d.difficulty)
+			if err != nil {
+				return duplicateDealMsg{deal: duplicateDeal{Board: board}, err: err}
+			}
+			players[i] = p
+		}
+
+		result, err := sim.PlayBoard(config, board, players)
+		return duplicateDealMsg{
+			deal: duplicateDeal{Board: board, You: dealNet(you, teams), AI: dealNet(result, teams)},
+			err:  err,
+		}
+	}
+}
+
+// compared reports whether a board has already been compared
+func (d *duplicateChallenge) compared(board engine.Board) bool {
+	for _, deal := range d.deals {
+		if deal.Board == board {
+			return true
+		}
+	}
+	return false
+}
+
+// record adds a compared deal and describes it for the round message
+func (d *duplicateChallenge) record(deal duplicateDeal) string {
+	d.deals = append(d.deals, deal)
+	return fmt.Sprintf("Duplicate: you %+d, AI in your seat %+d (running %+d).", deal.You, deal.AI, d.total())
+}
+
+// dealNet is team 0's points minus the other teams' on a deal
+func dealNet(r engine.RoundResult, teams int) int {
+	net := r.Points(0)
+	for team := 1; team < teams; team++ {
+		net -= r.Points(team)
+	}
+	return net
+}
@@ -0,0 +1,99 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestDuplicateChallengeComparesEachDealAndSurvivesASave(t *testing.T) {
+	t.Cleanup(deleteSavedGame)
+	g := NewGamePlayWithSettings(GameSettings{Variant: "Standard", Seed: 12, Duplicate: true})
+	g.isShuffling, g.isDealing = false, false
+	if g.duplicate == nil {
+		t.Fatal("the duplicate challenge setting should start a challenge")
+	}
+
+	board := g.game.Board()
+	for !g.game.NeedsNewRound() {
+		driveToPlay(t, g, 0)
+		if err := g.game.ApplyAction(g.game.LegalActions()[0]); err != nil {
+			t.Fatalf("apply failed: %v", err)
+		}
+	}
+
+	msg, ok := g.duplicate.compare(g.game)().(duplicateDealMsg)
+	if !ok || msg.err != nil {
+		t.Fatalf("compare returned %+v", msg)
+	}
+	if msg.deal.Board != board {
+		t.Fatalf("compared board %+v, want the dealt board %+v", msg.deal.Board, board)
+	}
+	if want := dealNet(g.game.Round().Result(), 2); msg.deal.You != want {
+		t.Errorf("your result = %+d, want %+d", msg.deal.You, want)
+	}
+
+	// A repeated report of the same board is counted once.
+	g.Update(msg)
+	g.Update(msg)
+	if len(g.duplicate.deals) != 1 {
+		t.Fatalf("recorded %d deals, want 1", len(g.duplicate.deals))
+	}
+
+	g.saveOnExit()
+	save, err := loadSavedGame()
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	resumed := NewGamePlayFromSave(save)
+	if resumed.duplicate == nil || len(resumed.duplicate.deals) != 1 || resumed.duplicate.total() != g.duplicate.total() {
+		t.Errorf("resumed challenge = %+v, want the saved deal", resumed.duplicate)
+	}
+}
+
+func TestDuplicateChallengeRefusesTakebacks(t *testing.T) {
+	g := NewGamePlayWithSettings(GameSettings{Variant: "Standard", Seed: 8, Duplicate: true})
+	g.isShuffling, g.isDealing = false, false
+	driveToPlay(t, g, 0)
+
+	// Play round the table until the human has played and it is their turn again.
+	var played bool
+	for !played || g.game.CurrentPlayer() != g.humanPlayer {
+		played = played || g.game.CurrentPlayer() == g.humanPlayer
+		if err := g.game.ApplyAction(g.game.LegalActions()[0]); err != nil {
+			t.Fatalf("apply failed: %v", err)
+		}
+	}
+
+	before := len(g.game.Round().ActionLog())
+	g.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	if g.game.Undos() != 0 || len(g.game.Round().ActionLog()) != before {
+		t.Error("a takeback was allowed in the duplicate challenge")
+	}
+}
+
+func TestDuplicateChallengeReportsAStrategyThatWontStart(t *testing.T) {
+	g := NewGamePlayWithSettings(GameSettings{Variant: "Standard", Seed: 12, Duplicate: true})
+	g.isShuffling, g.isDealing = false, false
+	for !g.game.NeedsNewRound() {
+		driveToPlay(t, g, 0)
+		if err := g.game.ApplyAction(g.game.LegalActions()[0]); err != nil {
+			t.Fatalf("apply failed: %v", err)
+		}
+	}
+
+	g.duplicate.strategy = "no-such-bot"
+	msg := g.duplicate.compare(g.game)().(duplicateDealMsg)
+	if msg.err == nil {
+		t.Fatal("an unknown strategy should fail the comparison, not fall back to another AI")
+	}
+	g.waitingForRoundAck = true
+	g.Update(msg)
+	if len(g.duplicate.deals) != 0 {
+		t.Errorf("a failed comparison was scored: %+v", g.duplicate.deals)
+	}
+	if !strings.Contains(g.message, "couldn't replay") {
+		t.Errorf("message = %q, want the failure reported", g.message)
+	}
+}
@@ -7,12 +7,17 @@ import (
 	"time"
 
 	"github.com/BrandonDedolph/euchre/internal/ai"
+	_ "github.com/BrandonDedolph/euchre/internal/ai/mcts" // registers the ISMCTS strategy
 	"github.com/BrandonDedolph/euchre/internal/ai/rule_based"
 	"github.com/BrandonDedolph/euchre/internal/engine"
 	"github.com/BrandonDedolph/euchre/internal/ui/components"
 	"github.com/BrandonDedolph/euchre/internal/ui/theme"
 	"github.com/BrandonDedolph/euchre/internal/variants"
+	_ "github.com/BrandonDedolph/euchre/internal/variants/british"   // registers British Euchre
+	_ "github.com/BrandonDedolph/euchre/internal/variants/cutthroat" // registers three-handed Cutthroat
+	_ "github.com/BrandonDedolph/euchre/internal/variants/sixhanded" // registers six-handed Euchre
 	"github.com/BrandonDedolph/euchre/internal/variants/standard"
+	_ "github.com/BrandonDedolph/euchre/internal/variants/twohanded" // registers two-handed Euchre
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -43,17 +48,19 @@ const (
 // GamePlay is the main game playing screen
 type GamePlay struct {
 	game               *engine.Game
+	settings           GameSettings // what the game was started with; saved alongside it
 	aiPlayers          []ai.Player
 	humanPlayer        int
-	tutorial           bool            // interactive-tutorial mode: show per-move coaching
-	coach              ai.Player       // strong AI used only to suggest the human's best move
-	shownConcepts      map[string]bool // teachable concepts already shown this game
-	pendingPopup       *concept        // teachable-moment modal currently displayed (nil = none)
-	gradeMsg           string          // feedback on the human's last move vs the coach (cleared next turn)
-	gradeGood          bool            // whether that move matched the coach
+	tutorial           bool                // interactive-tutorial mode: show per-move coaching
+	coach              ai.Player           // strong AI used only to suggest the human's best move
+	duplicate          *duplicateChallenge // duplicate challenge tally (nil when off)
+	shownConcepts      map[string]bool     // teachable concepts already shown this game
+	pendingPopup       *concept            // teachable-moment modal currently displayed (nil = none)
+	gradeMsg           string              // feedback on the human's last move vs the coach (cleared next turn)
+	gradeGood          bool                // whether that move matched the coach
 	selectedCard       int
 	message            string
-	playerAction       [4]string // latest per-seat action label (0=You,1=West,2=Partner,3=East)
+	playerAction       []string // latest per-seat action label (0=You,1=West,2=Partner,3=East)
 	tableView          *components.TableView
 	width              int
 	height             int
@@ -64,15 +71,15 @@ type GamePlay struct {
 	waitingForRoundAck bool                // Waiting for user to acknowledge round result
 
 	// Animation states
-	isShuffling       bool   // Shuffle animation before dealing
-	shuffleStep       int    // Current shuffle animation frame
-	trumpFlashFrames  int    // Frames remaining for trump flash effect
-	scoreAnimFrames   int    // Frames remaining for score animation
-	scoreDelta        [2]int // Score change to animate [team0, team1]
-	previousScores    [2]int // Scores before the animation
-	turnPulseFrame    int    // Frame counter for turn indicator pulse
-	celebrationFrames int    // Frames remaining for winner celebration
-	cardFlipFrames    int    // Frames remaining for card flip reveal
+	isShuffling       bool  // Shuffle animation before dealing
+	shuffleStep       int   // Current shuffle animation frame
+	trumpFlashFrames  int   // Frames remaining for trump flash effect
+	scoreAnimFrames   int   // Frames remaining for score animation
+	scoreDelta        []int // Score change to animate, per team
+	previousScores    []int // Scores before the animation
+	turnPulseFrame    int   // Frame counter for turn indicator pulse
+	celebrationFrames int   // Frames remaining for winner celebration
+	cardFlipFrames    int   // Frames remaining for card flip reveal
 
 	// Suit selector for bidding round 2
 	suitSelector *components.SuitSelector
@@ -83,26 +90,19 @@ type GamePlay struct {
 	showHelp bool
 }
 
-// rulesFromVariant maps a selected variant's options to the engine's Rules
-// struct. The variant is the single source of truth for rule invariants (e.g.
-// AllowMisdeal == !StickTheDealer), so all callers route through here rather
-// than hand-building Rules.
-func rulesFromVariant(v variants.Variant) engine.Rules {
-	return engine.Rules{
-		StickTheDealer:   v.HasStickTheDealer(),
-		AllowMisdeal:     v.AllowMisdeal(),
-		AllowDefendAlone: v.GetBoolOption("defend_alone", false),
-	}
-}
-
 // variantFromSettings builds a fresh, configured variant from the setup
-// screen's toggles. Only the standard variant exists today, so we construct it
-// directly; when more variants are added this should look up s.Variant in the
-// registry (constructing a fresh instance to avoid mutating shared singletons).
+// screen's toggles. The registry hands out a new instance so the registered
+// one is never mutated; an unknown name falls back to the standard variant.
 func variantFromSettings(s GameSettings) variants.Variant {
-	v := standard.New()
+	v, ok := variants.New(s.Variant)
+	if !ok {
+		v = standard.New()
+	}
 	_ = v.SetOption("stick_the_dealer", s.StickTheDealer)
 	_ = v.SetOption("defend_alone", s.DefendAlone)
+	if variants.Offers(v, "sevens_and_eights") {
+		_ = v.SetOption("sevens_and_eights", s.SevensAndEights)
+	}
 	return v
 }
 
@@ -110,39 +110,88 @@ func variantFromSettings(s GameSettings) variants.Variant {
 // the standard variant with all optional rules off. This preserves the
 // behavior of the original constructor for callers that have no settings.
 func NewGamePlay() *GamePlay {
-	// Map the standard variant's default options onto the engine's plain Rules
-	// struct. The engine cannot import variants (that would be a circular
+	// Map the standard variant's default options onto the engine's plain
+	// config. The engine cannot import variants (that would be a circular
 	// import), so the app layer does this translation.
-	return newGamePlay(rulesFromVariant(standard.New()), false, ai.DifficultyMedium)
+	gp := newGamePlay(standard.New(), false, ai.DefaultStrategy, ai.DifficultyMedium, 0)
+	gp.settings = GameSettings{Variant: "Standard", Difficulty: ai.DifficultyMedium, Seed: gp.game.Seed()}
+	return gp
 }
 
 // NewGamePlayWithSettings creates a new game play screen using the rule toggles
 // chosen on the setup screen. When s.Tutorial is set the interactive coach is
 // enabled (hands are still randomly dealt — only the per-move tips are added).
 func NewGamePlayWithSettings(s GameSettings) *GamePlay {
-	return newGamePlay(rulesFromVariant(variantFromSettings(s)), s.Tutorial, s.Difficulty)
+	gp := newGamePlay(variantFromSettings(s), s.Tutorial, s.Strategy, s.Difficulty, s.Seed)
+	s.Seed = gp.game.Seed()
+	gp.settings = s
+	if s.Duplicate {
+		gp.duplicate = newDuplicateChallenge(s.Strategy, s.Difficulty, nil)
+	}
+	return gp
+}
+
+// NewGamePlayFromSave resumes a saved game exactly where it was left: scores,
+// dealer, hands and the active phase, including a defend-alone window or a
+// partially played trick. The shuffle and deal animations are skipped.
+func NewGamePlayFromSave(save *SavedGame) *GamePlay {
+	gp := newGamePlayForGame(save.Game, save.Settings.Tutorial, save.Settings.Strategy, save.Settings.Difficulty)
+	gp.settings = save.Settings
+	if save.Settings.Duplicate {
+		gp.duplicate = newDuplicateChallenge(save.Settings.Strategy, save.Settings.Difficulty, save.Duplicate)
+	}
+	gp.isShuffling = false
+	gp.dealStep = len(dealPacketPlan(save.Game.Dealer(), save.Game.NumPlayers()))
+
+	// Scores animate from their saved values, not from zero.
+	copy(gp.previousScores, save.Game.Scores())
+
+	gp.message = "Game resumed"
+	gp.updateTableView()
+	return gp
 }
 
 // newGamePlay is the shared constructor body. It builds the game from the given
-// engine rules and wires up the human/AI players, animation state, and starts
-// the first round.
-func newGamePlay(rules engine.Rules, tutorial bool, difficulty ai.Difficulty) *GamePlay {
-	config := engine.DefaultGameConfig()
-	config.Rules = rules
+// variant and wires up the human/AI players, animation state, and starts
+// the first round. A zero seed picks a fresh one, so every game is seeded and
+// can be replayed exactly from the seed shown on the help sheet.
+func newGamePlay(v variants.Variant, tutorial bool, strategy string, difficulty ai.Difficulty, seed int64) *GamePlay {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	// The variant is the single source of truth for rule invariants (e.g.
+	// AllowMisdeal == !StickTheDealer), so the engine config comes from it
+	// rather than being built by hand.
+	config := variants.EngineConfig(v)
+	config.Seed = seed
 
 	game := engine.NewGame(config)
+	gp := newGamePlayForGame(game, tutorial, strategy, difficulty)
+
+	// Start the first round (cards are dealt in engine, animation is visual only)
+	game.StartRound()
+	gp.updateDealingView() // Show empty hands initially
 
+	return gp
+}
+
+// newGamePlayForGame wires up the human/AI players and animation state around
+// an existing game without dealing.
+func newGamePlayForGame(game *engine.Game, tutorial bool, strategy string, difficulty ai.Difficulty) *GamePlay {
 	gp := &GamePlay{
-		game:         game,
-		humanPlayer:  0, // Player 0 is the human
-		aiPlayers:    rule_based.CreateAIPlayers(0, difficulty),
-		tutorial:     tutorial,
-		selectedCard: 0,
-		tableView:    components.NewTableView(),
-		isShuffling:  true, // Start with shuffle animation
-		shuffleStep:  0,
-		isDealing:    false,
-		dealStep:     0,
+		game:           game,
+		humanPlayer:    0, // Player 0 is the human
+		aiPlayers:      createAIPlayers(0, game.NumPlayers(), strategy, difficulty),
+		tutorial:       tutorial,
+		selectedCard:   0,
+		tableView:      components.NewTableViewFor(game.NumPlayers(), game.NumTeams()),
+		playerAction:   make([]string, game.NumPlayers()),
+		scoreDelta:     make([]int, game.NumTeams()),
+		previousScores: make([]int, game.NumTeams()),
+		isShuffling:    true, // Start with shuffle animation
+		shuffleStep:    0,
+		isDealing:      false,
+		dealStep:       0,
 	}
 
 	// In tutorial mode a strong AI sitting in the human's seat supplies the
@@ -152,13 +201,27 @@ func newGamePlay(rules engine.Rules, tutorial bool, difficulty ai.Difficulty) *G
 		gp.shownConcepts = make(map[string]bool)
 	}
 
-	// Start the first round (cards are dealt in engine, animation is visual only)
-	game.StartRound()
-	gp.updateDealingView() // Show empty hands initially
-
 	return gp
 }
 
+// createAIPlayers seats the named AI strategy in every seat but the human's.
+// A strategy that isn't registered (say, from an old save) falls back to the
+// rule-based AI.
+func createAIPlayers(humanPlayer, numPlayers int, strategy string, difficulty ai.Difficulty) []ai.Player {
+	players := make([]ai.Player, numPlayers)
+	for i := range players {
+		if i == humanPlayer {
+			continue // Human player slot
+		}
+		p, err := ai.NewPlayer(strategy, ai.PlayerNames[i], i, difficulty)
+		if err != nil {
+			p = rule_based.New(ai.PlayerNames[i], i, difficulty)
+		}
+		players[i] = p
+	}
+	return players
+}
+
 // Init implements tea.Model
 func (g *GamePlay) Init() tea.Cmd {
 	// Start turn pulse animation
@@ -242,7 +305,7 @@ func (g *GamePlay) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		g.dealStep++
 		g.updateDealingView()
-		if g.dealStep >= len(dealPacketPlan(g.game.Dealer())) { // all packets dealt
+		if g.dealStep >= len(dealPacketPlan(g.game.Dealer(), g.game.NumPlayers())) { // all packets dealt
 			g.isDealing = false
 			g.message = "Revealing turned card..."
 			// Start card flip animation
@@ -284,95 +347,24 @@ func (g *GamePlay) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return g, nil
 
 	case roundCompleteMsg:
-		// Show round results and wait for acknowledgment
-		g.waitingForRoundAck = true
-		scores := g.game.Scores()
-
-		// Calculate score delta and start animation
-		g.scoreDelta[0] = scores[0] - g.previousScores[0]
-		g.scoreDelta[1] = scores[1] - g.previousScores[1]
-		g.previousScores[0] = scores[0]
-		g.previousScores[1] = scores[1]
-		if g.scoreDelta[0] != 0 || g.scoreDelta[1] != 0 {
-			g.scoreAnimFrames = scoreAnimTotal
+		model, cmd := g.showRoundResult()
+		if g.duplicate != nil {
+			cmd = tea.Batch(cmd, g.duplicate.compare(g.game))
 		}
+		return model, cmd
 
-		// A misdeal (round-2 throw-in) appends nothing to history and changes no
-		// scores, so the history-based result below would show a stale/zero
-		// result. Handle it explicitly with a clear re-deal message.
-		if g.game.IsMisdeal() {
-			g.message = "Throw-in — everyone passed. Re-dealing…"
+	case duplicateDealMsg:
+		if g.duplicate == nil || g.duplicate.compared(msg.deal.Board) {
 			return g, nil
 		}
-
-		// Surface a teachable popup for a euchre or march now that the round
-		// result is in history (idle point: we're waiting on the round ack).
-		g.maybeShowTeachable()
-
-		// Get round result details
-		roundHistory := g.game.RoundHistory()
-		var roundMsg string
-		if len(roundHistory) > 0 {
-			lastRound := roundHistory[len(roundHistory)-1]
-			yourTeamMade := lastRound.Makers == 0
-
-			if lastRound.WasEuchred {
-				if yourTeamMade {
-					roundMsg = "Euchred! Opponents score 2 points."
-				} else {
-					roundMsg = "You euchred them! +2 points!"
-				}
-			} else if lastRound.MakerTricks == 5 {
-				if yourTeamMade {
-					if lastRound.WasAlone {
-						roundMsg = "March going alone! +4 points!"
-					} else {
-						roundMsg = "March! +2 points!"
-					}
-				} else {
-					if lastRound.WasAlone {
-						roundMsg = "Opponents march alone for 4 points."
-					} else {
-						roundMsg = "Opponents march for 2 points."
-					}
-				}
-			} else {
-				if yourTeamMade {
-					roundMsg = fmt.Sprintf("Made it with %d tricks. +1 point.", lastRound.MakerTricks)
-				} else {
-					roundMsg = fmt.Sprintf("Opponents made it with %d tricks.", lastRound.MakerTricks)
-				}
-			}
+		note := fmt.Sprintf("Duplicate: the AI couldn't replay this deal (%v).", msg.err)
+		if msg.err == nil {
+			note = g.duplicate.record(msg.deal)
 		}
-
-		// Build command for score animation
-		var cmd tea.Cmd
-		if g.scoreAnimFrames > 0 {
-			cmd = tea.Tick(scoreAnimDelay, func(t time.Time) tea.Msg {
-				return scoreAnimTickMsg{}
-			})
+		if g.waitingForRoundAck {
+			g.message += " " + note
 		}
-
-		if g.game.IsOver() {
-			winner := g.game.Winner()
-			if winner == 0 {
-				g.message = fmt.Sprintf("%s Game Over! Your team wins %d-%d!", roundMsg, scores[0], scores[1])
-				// Trigger celebration animation for winning
-				g.celebrationFrames = celebrationTotal
-				celebCmd := tea.Tick(celebrationDelay, func(t time.Time) tea.Msg {
-					return celebrationTickMsg{}
-				})
-				if cmd != nil {
-					return g, tea.Batch(cmd, celebCmd)
-				}
-				return g, celebCmd
-			} else {
-				g.message = fmt.Sprintf("%s Game Over! Opponents win %d-%d.", roundMsg, scores[1], scores[0])
-			}
-		} else {
-			g.message = fmt.Sprintf("%s Score: You %d - Opponents %d", roundMsg, scores[0], scores[1])
-		}
-		return g, cmd
+		return g, nil
 
 	case aiErrorMsg:
 		// AI action failed - display error and return to menu
@@ -505,7 +497,7 @@ func (g *GamePlay) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// During dealing animation, only allow quit
 	if g.isDealing {
 		if msg.String() == "q" || msg.String() == "esc" {
-			return g, Navigate(ScreenMainMenu)
+			return g.quitToMenu()
 		}
 		return g, nil
 	}
@@ -529,7 +521,7 @@ func (g *GamePlay) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			g.waitingForRoundAck = false
 			g.message = ""
 			if g.game.IsOver() {
-				return g, Navigate(ScreenMainMenu)
+				return g.quitToMenu()
 			}
 			// Start next round with shuffle animation
 			g.game.StartRound()
@@ -542,7 +534,7 @@ func (g *GamePlay) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				return shuffleTickMsg{}
 			})
 		case "q", "esc":
-			return g, Navigate(ScreenMainMenu)
+			return g.quitToMenu()
 		}
 		return g, nil
 	}
@@ -588,7 +580,7 @@ func (g *GamePlay) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			}
 			return g, g.processAITurns()
 		case "q", "esc":
-			return g, Navigate(ScreenMainMenu)
+			return g.quitToMenu()
 		}
 		return g, nil
 	}
@@ -607,7 +599,9 @@ func (g *GamePlay) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if phase == engine.PhaseDefendAlone && g.game.CurrentPlayer() == g.humanPlayer {
 		switch msg.String() {
 		case "q", "esc":
-			return g, Navigate(ScreenMainMenu)
+			return g.quitToMenu()
+		case "u":
+			return g.handleUndo()
 		case "y":
 			return g.handleDefendAlone(true)
 		case "n", "p", "enter", " ":
@@ -618,7 +612,7 @@ func (g *GamePlay) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	switch msg.String() {
 	case "q", "esc":
-		return g, Navigate(ScreenMainMenu)
+		return g.quitToMenu()
 
 	case "left", "h":
 		if phase == engine.PhaseBidRound2 && g.suitSelector != nil && g.game.CurrentPlayer() == g.humanPlayer {
@@ -644,6 +638,10 @@ func (g *GamePlay) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		// Pass during bidding
 		return g.handlePass()
 
+	case "u":
+		// Take back your last decision
+		return g.handleUndo()
+
 	case "a":
 		// Go alone during bidding
 		return g.handleAlone()
@@ -664,6 +662,245 @@ func (g *GamePlay) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return g, nil
 }
 
+// showRoundResult shows the finished round's result and waits for the
+// player to acknowledge it.
+func (g *GamePlay) showRoundResult() (tea.Model, tea.Cmd) {
+	// Show round results and wait for acknowledgment
+	g.waitingForRoundAck = true
+	scores := g.game.Scores()
+
+	// Calculate score delta and start animation
+	for team, score := range scores {
+		g.scoreDelta[team] = score - g.previousScores[team]
+		g.previousScores[team] = score
+		if g.scoreDelta[team] != 0 {
+			g.scoreAnimFrames = scoreAnimTotal
+		}
+	}
+
+	// A misdeal (round-2 throw-in) appends nothing to history and changes no
+	// scores, so the history-based result below would show a stale/zero
+	// result. Handle it explicitly with a clear re-deal message.
+	if g.game.IsMisdeal() {
+		g.message = "Throw-in — everyone passed. Re-dealing…"
+		return g, nil
+	}
+
+	// Surface a teachable popup for a euchre or march now that the round
+	// result is in history (idle point: we're waiting on the round ack).
+	g.maybeShowTeachable()
+
+	// Get round result details
+	roundHistory := g.game.RoundHistory()
+	var roundMsg string
+	if len(roundHistory) > 0 {
+		lastRound := roundHistory[len(roundHistory)-1]
+		yourTeamMade := lastRound.Makers == g.game.Team(g.humanPlayer)
+
+		if lastRound.WasEuchred {
+			if yourTeamMade && g.game.NumTeams() > 2 {
+				roundMsg = fmt.Sprintf("Euchred! %s score %d points each.", g.opponentsName(), lastRound.DefendPoints)
+			} else if yourTeamMade {
+				roundMsg = "Euchred! Opponents score 2 points."
+			} else {
+				roundMsg = "You euchred them! +2 points!"
+			}
+		} else if lastRound.MakerTricks == g.game.Rules().TrickCount() {
+			if yourTeamMade {
+				if lastRound.WasAlone {
+					roundMsg = "March going alone! +4 points!"
+				} else {
+					roundMsg = "March! +2 points!"
+				}
+			} else {
+				if lastRound.WasAlone {
+					roundMsg = "Opponents march alone for 4 points."
+				} else {
+					roundMsg = "Opponents march for 2 points."
+				}
+			}
+		} else {
+			if yourTeamMade {
+				roundMsg = fmt.Sprintf("Made it with %d tricks. +1 point.", lastRound.MakerTricks)
+			} else {
+				roundMsg = fmt.Sprintf("Opponents made it with %d tricks.", lastRound.MakerTricks)
+			}
+		}
+	}
+
+	// Build command for score animation
+	var cmd tea.Cmd
+	if g.scoreAnimFrames > 0 {
+		cmd = tea.Tick(scoreAnimDelay, func(t time.Time) tea.Msg {
+			return scoreAnimTickMsg{}
+		})
+	}
+
+	if g.game.IsOver() && g.game.NumTeams() > 2 {
+		// More than one other side: name the winners and list the scores.
+		winner := g.game.Winner()
+		who := g.teamName(winner) + " win"
+		if g.game.NumTeams() == g.game.NumPlayers() {
+			who += "s"
+		}
+		if winner == g.game.Team(g.humanPlayer) {
+			who = "You win"
+		}
+		g.message = fmt.Sprintf("%s Game Over! %s! %s", roundMsg, who, g.scoreLine())
+		if winner != g.game.Team(g.humanPlayer) {
+			return g, cmd
+		}
+		g.celebrationFrames = celebrationTotal
+		return g, tea.Batch(cmd, tea.Tick(celebrationDelay, func(t time.Time) tea.Msg {
+			return celebrationTickMsg{}
+		}))
+	}
+	if g.game.IsOver() {
+		winner := g.game.Winner()
+		if winner == 0 {
+			g.message = fmt.Sprintf("%s Game Over! Your team wins %d-%d!", roundMsg, scores[0], scores[1])
+			// Trigger celebration animation for winning
+			g.celebrationFrames = celebrationTotal
+			celebCmd := tea.Tick(celebrationDelay, func(t time.Time) tea.Msg {
+				return celebrationTickMsg{}
+			})
+			if cmd != nil {
+				return g, tea.Batch(cmd, celebCmd)
+			}
+			return g, celebCmd
+		} else {
+			g.message = fmt.Sprintf("%s Game Over! Opponents win %d-%d.", roundMsg, scores[1], scores[0])
+		}
+	} else {
+		g.message = fmt.Sprintf("%s Score: %s", roundMsg, g.scoreLine())
+	}
+	return g, cmd
+}
+
+// scoreLine lists the scores, e.g. "You 4 - Opponents 6", or with every
+// player for themselves "You 4 - West 6 - East 2"
+func (g *GamePlay) scoreLine() string {
+	scores := g.game.Scores()
+	you := g.game.Team(g.humanPlayer)
+	parts := []string{fmt.Sprintf("You %d", scores[you])}
+	for _, team := range g.opponentTeams() {
+		parts = append(parts, fmt.Sprintf("%s %d", g.teamName(team), scores[team]))
+	}
+	return strings.Join(parts, " - ")
+}
+
+// opponentTeams returns every team but the human's
+func (g *GamePlay) opponentTeams() []int {
+	var teams []int
+	for team := 0; team < g.game.NumTeams(); team++ {
+		if team != g.game.Team(g.humanPlayer) {
+			teams = append(teams, team)
+		}
+	}
+	return teams
+}
+
+// teamName names an opposing team: "Opponents" when there is just the one,
+// otherwise its players, e.g. "West" or "West & Far East"
+func (g *GamePlay) teamName(team int) string {
+	if g.game.NumTeams() == 2 {
+		return "Opponents"
+	}
+	return strings.Join(g.teamMembers(team), " & ")
+}
+
+// teamMembers returns the names of a team's players
+func (g *GamePlay) teamMembers(team int) []string {
+	var names []string
+	for seat := 0; seat < g.game.NumPlayers(); seat++ {
+		if g.game.Team(seat) == team {
+			names = append(names, g.tableView.PlayerNames[seat])
+		}
+	}
+	return names
+}
+
+// opponentsName names everyone the human plays against, e.g. "West and East"
+func (g *GamePlay) opponentsName() string {
+	var names []string
+	for _, team := range g.opponentTeams() {
+		names = append(names, g.teamName(team))
+	}
+	return strings.Join(names, " and ")
+}
+
+// teamTricks returns the tricks won this round by the human's team, or with
+// opponents set, by everyone else
+func (g *GamePlay) teamTricks(opponents bool) int {
+	r := g.game.Round()
+	if r == nil {
+		return 0
+	}
+	total := 0
+	for seat := 0; seat < g.game.NumPlayers(); seat++ {
+		if (g.game.Team(seat) != g.game.Team(g.humanPlayer)) == opponents {
+			total += r.TricksWon(seat)
+		}
+	}
+	return total
+}
+
+// quitToMenu leaves the game for the main menu, saving it first so it can be
+// resumed. A finished game clears the save instead.
+func (g *GamePlay) quitToMenu() (tea.Model, tea.Cmd) {
+	g.saveOnExit()
+	return g, Navigate(ScreenMainMenu)
+}
+
+// saveOnExit persists the game in progress and the hands played so far (for
+// the replay viewer). Tutorial hands are never saved so they can't overwrite a
+// real game waiting to be resumed.
+func (g *GamePlay) saveOnExit() {
+	if g.tutorial {
+		return
+	}
+	if hands := g.game.HandHistories(); len(hands) > 0 {
+		_ = writeHandHistories(hands)
+	}
+	if g.game.IsOver() {
+		deleteSavedGame()
+		return
+	}
+	// Best effort: failing to save must never block leaving the game.
+	save := SavedGame{Settings: g.settings, Game: g.game}
+	if g.duplicate != nil {
+		save.Duplicate = g.duplicate.deals
+	}
+	_ = writeSavedGame(save)
+}
+
+// handleUndo takes back the human's most recent decision this hand, rewinding
+// the AI moves made since. It is only offered on the human's turn, when no AI
+// move or animation is in flight that could act on the rewound state. The
+// engine counts every takeback (Game.Undos), so the game stays flagged as
+// having used them. The duplicate challenge scores the human's play against
+// the AI's, so takebacks are off there.
+func (g *GamePlay) handleUndo() (tea.Model, tea.Cmd) {
+	if g.duplicate != nil {
+		return g.showTempMessage("Takebacks are off in the Duplicate Challenge")
+	}
+	if g.game.CurrentPlayer() != g.humanPlayer || g.tableView.CardPlayAnim != nil {
+		return g.showTempMessage("You can take back a move on your turn")
+	}
+	if err := g.game.Undo(g.humanPlayer); err != nil {
+		return g.showTempMessage("Nothing to take back this hand")
+	}
+
+	g.suitSelector = nil
+	g.completedTrick = nil
+	g.gradeMsg = ""
+	g.clearActions()
+	g.message = "Took back your last move"
+	g.selectedCard = g.firstLegalCardIndex()
+	g.updateTableView()
+	return g, nil
+}
+
 // handleAction handles the main action (playing a card or ordering up)
 func (g *GamePlay) handleAction() (tea.Model, tea.Cmd) {
 	phase := g.game.Phase()
@@ -714,17 +951,18 @@ func (g *GamePlay) handleAction() (tea.Model, tea.Cmd) {
 		if g.selectedCard >= 0 && g.selectedCard < len(hand) {
 			card := hand[g.selectedCard]
 			coachCard := g.coachWould(func(s *engine.GameState) engine.Card {
-				return g.coach.DecideDiscard(s, hand)
+				return g.coach.DecideDiscard(s, g.game.Discardable())
 			})
 			action := engine.DiscardAction{
 				PlayerIdx: g.humanPlayer,
 				Card:      card,
 			}
+			label := g.discardLabel()
 			if err := g.game.ApplyAction(action); err != nil {
 				g.message = err.Error()
 			} else {
 				g.message = fmt.Sprintf("Discarded %s", card)
-				g.setAction(g.humanPlayer, "picks it up")
+				g.setAction(g.humanPlayer, label)
 				g.gradeCard("discard", card, coachCard)
 				g.selectedCard = 0
 				g.updateTableView()
@@ -988,14 +1226,15 @@ func (g *GamePlay) processAITurns() tea.Cmd {
 			return aiBidMsg{message: bidMsg}
 
 		case engine.PhaseDiscard:
-			hand := g.game.Hand(current)
-			card := aiPlayer.DecideDiscard(state, hand)
+			card := aiPlayer.DecideDiscard(state, g.game.Discardable())
 			action := engine.DiscardAction{PlayerIdx: current, Card: card}
+			label := g.discardLabel()
 			if err := g.game.ApplyAction(action); err != nil {
 				return aiErrorMsg{err: err, player: current, action: "discard"}
 			}
-			// The dealer (current player here) just picked up the turn card.
-			g.setAction(current, "picks it up")
+			// The dealer just picked up the turn card, or a lone maker took
+			// their partners' cards.
+			g.setAction(current, label)
 
 		case engine.PhaseDefendAlone:
 			playerName := g.tableView.PlayerNames[current]
@@ -1157,10 +1396,11 @@ func (g *GamePlay) updateTableView() {
 	g.tableView.PlayerActions = g.playerAction
 
 	// Update player hand counts
-	for i := 0; i < 4; i++ {
+	for i := 0; i < g.game.NumPlayers(); i++ {
 		g.tableView.PlayerHands[i] = len(g.game.Hand(i))
 		g.tableView.TricksWon[i] = round.TricksWon(i)
 	}
+	g.tableView.Stacks = faceUpStacks(round)
 
 	// Update current trick
 	g.tableView.CurrentTrick = round.CurrentTrick()
@@ -1183,10 +1423,26 @@ func (g *GamePlay) refreshSeatCounts() {
 	if round == nil {
 		return
 	}
-	for i := 0; i < 4; i++ {
+	for i := 0; i < g.game.NumPlayers(); i++ {
 		g.tableView.PlayerHands[i] = len(g.game.Hand(i))
 		g.tableView.TricksWon[i] = round.TricksWon(i)
 	}
+	g.tableView.Stacks = faceUpStacks(round)
+}
+
+// faceUpStacks returns the face-up stack cards each seat has on the table,
+// or nil when the round deals no stacks
+func faceUpStacks(round *engine.Round) [][]engine.Card {
+	if round.Rules().Stacks == 0 {
+		return nil
+	}
+	stacks := make([][]engine.Card, round.NumPlayers())
+	for seat := range stacks {
+		for _, st := range round.Stacks(seat) {
+			stacks[seat] = append(stacks[seat], st.Up)
+		}
+	}
+	return stacks
 }
 
 // nextDealCard returns a command to deal the next card after a delay
@@ -1196,17 +1452,30 @@ func (g *GamePlay) nextDealCard() tea.Cmd {
 	})
 }
 
+// discardLabel is the seat label for the discard about to be made: the
+// dealer picks up the turned card, and a lone maker swaps cards with their
+// partners.
+func (g *GamePlay) discardLabel() string {
+	if round := g.game.Round(); round != nil && round.Exchanged() {
+		return "swaps with partner"
+	}
+	return "picks it up"
+}
+
 // dealPacketPlan returns the Euchre deal as an ordered list of {player, count}
-// packets, mirroring the engine's dealPasses for 4 players: packets of 2,3,2,3
-// on the first pass then 3,2,3,2 on the second, starting to the dealer's left.
-// Each player ends with 5 cards.
-func dealPacketPlan(dealer int) [][2]int {
-	passes := [2][4]int{{2, 3, 2, 3}, {3, 2, 3, 2}}
-	plan := make([][2]int, 0, 8)
-	for _, pass := range passes {
-		for i := 0; i < 4; i++ {
-			player := (dealer + 1 + i) % 4
-			plan = append(plan, [2]int{player, pass[i]})
+// packets, mirroring the engine's dealPasses: for 4 players packets of 2,3,2,3
+// on the first pass then 3,2,3,2 on the second, otherwise 3s then 2s,
+// starting to the dealer's left. Each player ends with 5 cards.
+func dealPacketPlan(dealer, numPlayers int) [][2]int {
+	plan := make([][2]int, 0, 2*numPlayers)
+	for pass := 0; pass < 2; pass++ {
+		for i := 0; i < numPlayers; i++ {
+			player := (dealer + 1 + i) % numPlayers
+			count := 3 - pass
+			if numPlayers == 4 {
+				count = 2 + (i+pass)%2
+			}
+			plan = append(plan, [2]int{player, count})
 		}
 	}
 	return plan
@@ -1216,21 +1485,21 @@ func dealPacketPlan(dealer int) [][2]int {
 // one packet (2 or 3 cards) per step rather than a single card at a time.
 func (g *GamePlay) updateDealingView() {
 	dealer := g.game.Dealer()
-	plan := dealPacketPlan(dealer)
+	plan := dealPacketPlan(dealer, g.game.NumPlayers())
 
 	step := g.dealStep
 	if step > len(plan) {
 		step = len(plan)
 	}
 
-	cardCounts := [4]int{0, 0, 0, 0}
+	cardCounts := make([]int, g.game.NumPlayers())
 	for i := 0; i < step; i++ {
 		player, count := plan[i][0], plan[i][1]
 		cardCounts[player] += count
 	}
 
 	// Update table view with animated card counts (cap at 5)
-	for i := 0; i < 4; i++ {
+	for i := range cardCounts {
 		if cardCounts[i] > 5 {
 			cardCounts[i] = 5
 		}
@@ -1238,6 +1507,7 @@ func (g *GamePlay) updateDealingView() {
 		g.tableView.TricksWon[i] = 0
 	}
 
+	g.tableView.Stacks = nil
 	g.tableView.Dealer = dealer
 	g.tableView.CurrentPlayer = -1 // No one's turn during dealing
 	g.tableView.CurrentTrick = nil
@@ -1318,6 +1588,11 @@ func (g *GamePlay) View() string {
 		if g.game.Dealer() == g.humanPlayer {
 			playerName += " " + dealerStyle.Render("DEALER")
 		}
+		if round != nil {
+			if n := len(round.Stacks(g.humanPlayer)); n > 0 {
+				playerName += " " + tricksStyle.Render(fmt.Sprintf("+%d face down", n))
+			}
+		}
 
 		phase := g.game.Phase()
 		isYourTurn := g.game.CurrentPlayer() == g.humanPlayer
@@ -1328,6 +1603,10 @@ func (g *GamePlay) View() string {
 				legalPlays = engine.LegalPlays(engine.NewHandWith(hand), round.Trick())
 			}
 		}
+		// Face-up stack cards stay on the table, so they can't be discarded.
+		if phase == engine.PhaseDiscard && isYourTurn && len(round.Stacks(g.humanPlayer)) > 0 {
+			legalPlays = g.game.Discardable()
+		}
 
 		// Only show selection when it's your turn to select a card
 		// Must be in discard/play phase, your turn, and not waiting for acknowledgment
@@ -1619,12 +1898,28 @@ func (g *GamePlay) getPhaseMessage() string {
 		return fmt.Sprintf("Waiting for %s to bid...", g.tableView.PlayerNames[current])
 
 	case engine.PhaseBidRound2:
+		if isYourTurn && g.game.TurnedCard().IsJoker() {
+			return "You turned up the Benny: select a trump suit (you take the Benny)"
+		}
+		if isYourTurn && g.game.Round().MustCall() {
+			return "Stuck as dealer: select a trump suit"
+		}
 		if isYourTurn {
 			return "Round 2: Select a suit or pass"
 		}
 		return fmt.Sprintf("Waiting for %s to bid...", g.tableView.PlayerNames[current])
 
 	case engine.PhaseDiscard:
+		if round := g.game.Round(); round != nil && round.Exchanged() {
+			if isYourTurn {
+				if round.NumPlayers()/round.NumTeams() > 2 {
+					extra := len(g.game.Hand(g.humanPlayer)) - g.game.Rules().HandSize()
+					return fmt.Sprintf("Your partners passed you their best cards. Select %d to discard.", extra)
+				}
+				return "Your partner passed you their best card. Select a card to discard."
+			}
+			return fmt.Sprintf("%s is taking their partners' cards and discarding...", g.tableView.PlayerNames[current])
+		}
 		if isYourTurn {
 			return "You picked up the trump card. Select a card to discard."
 		}
@@ -1689,7 +1984,7 @@ func (g *GamePlay) renderHandArea(playerName string, phase engine.GamePhase, isY
 	switch {
 	case phase == engine.PhaseBidRound2 && isYourTurn && g.suitSelector != nil:
 		subLine = g.suitSelector.Render()
-	case phase == engine.PhaseDiscard && handLen == 6:
+	case phase == engine.PhaseDiscard && handLen > g.game.Rules().HandSize():
 		subLine = theme.Current.Muted.Render("(select one to discard)")
 	}
 	header := lipgloss.JoinVertical(lipgloss.Center, playerName, subLine)
@@ -1755,6 +2050,9 @@ func (g *GamePlay) handChips(phase engine.GamePhase, isYourTurn bool) string {
 	case engine.PhaseBidRound1:
 		return strings.Join([]string{keyCap("⏎", "Order up"), keyCap("P", "Pass"), keyCap("A", "Alone")}, sep)
 	case engine.PhaseBidRound2:
+		if g.game.Round().MustCall() {
+			return keyCap("⏎", "Call")
+		}
 		return strings.Join([]string{keyCap("⏎", "Call"), keyCap("P", "Pass")}, sep)
 	case engine.PhaseDefendAlone:
 		return strings.Join([]string{keyCap("Y", "Defend alone"), keyCap("N", "Decline")}, sep)
@@ -1762,6 +2060,24 @@ func (g *GamePlay) handChips(phase engine.GamePhase, isYourTurn bool) string {
 	return ""
 }
 
+// undoHelp describes the takeback key for the help sheet
+func (g *GamePlay) undoHelp() string {
+	if g.duplicate != nil {
+		return "Take back (off in Duplicate Challenge)"
+	}
+	return "Take back your last move"
+}
+
+// seedLine reports the game's seed for the help sheet, plus how many moves
+// were taken back, since a game with takebacks isn't a straight replay of it.
+func (g *GamePlay) seedLine() string {
+	line := fmt.Sprintf("Game seed: %d", g.game.Seed())
+	if n := g.game.Undos(); n > 0 {
+		line += fmt.Sprintf(" · Takebacks: %d", n)
+	}
+	return line
+}
+
 // renderHelpSheet is the full keybind reference shown as an in-place overlay
 // when "?" is pressed (g.showHelp). It lists every control grouped by phase so
 // players can see the whole scheme without leaving the game in progress.
@@ -1780,10 +2096,14 @@ func (g *GamePlay) renderHelpSheet() string {
 		row("P", "Pass"),
 		row("A", "Order up alone"),
 		row("Y / N", "Defend alone / decline"),
+		row("U", g.undoHelp()),
 		row("Enter", "Continue to next trick / round"),
 		row("?", "Toggle this help"),
 		row("Esc  q", "Quit to menu"),
 		"",
+		// The seed reproduces every deal of this game (euchre play --seed N),
+		// so it is what a bug report needs.
+		theme.Current.Muted.Render(g.seedLine()),
 		theme.Current.Muted.Italic(true).Render("Press any key to close"),
 	}
 	body := lipgloss.JoinVertical(lipgloss.Left, lines...)
@@ -1798,22 +2118,20 @@ func (g *GamePlay) renderHelpSheet() string {
 // fills it to the table height so it flanks the left side of the table.
 func (g *GamePlay) renderYouCard() string {
 	scores := g.game.Scores()
-	var yourTricks int
-	if r := g.game.Round(); r != nil {
-		yourTricks = r.TricksWon(0) + r.TricksWon(2)
-	}
+	yourTricks := g.teamTricks(false)
+	you := g.game.Team(g.humanPlayer)
 
 	scoreStyle := lipgloss.NewStyle().Foreground(theme.ColGreen).Bold(true)
-	scoreStr := fmt.Sprintf("%d pts", scores[0])
-	if g.scoreAnimFrames > 0 && g.scoreDelta[0] > 0 {
-		scoreStr = fmt.Sprintf("%d (+%d) pts", scores[0], g.scoreDelta[0])
+	scoreStr := fmt.Sprintf("%d pts", scores[you])
+	if g.scoreAnimFrames > 0 && g.scoreDelta[you] > 0 {
+		scoreStr = fmt.Sprintf("%d (+%d) pts", scores[you], g.scoreDelta[you])
 		scoreStyle = scoreStyle.Background(theme.ColGreen).Foreground(lipgloss.Color("#FFF"))
 	}
 
 	body := []string{
 		panelCenter(scoreStyle, scoreStr),
 		"",
-		panelTricks(yourTricks, theme.ColGreen),
+		panelTricks(yourTricks, g.game.Rules().TrickCount(), theme.ColGreen),
 	}
 
 	return boxFrame("YOU", theme.ColGreen, lipgloss.JoinVertical(lipgloss.Center, body...), panelInnerWidth)
@@ -1870,13 +2188,13 @@ func panelCenter(style lipgloss.Style, s string) string {
 	return style.Width(panelInnerWidth).Align(lipgloss.Center).Render(s)
 }
 
-// trickDots returns the 5-pip tracker string (filled in the team accent, empty
-// muted), with no surrounding placement.
-func trickDots(n int, accent lipgloss.TerminalColor) string {
+// trickDots returns the pip tracker string, one pip per trick in the round
+// (filled in the team accent, empty muted), with no surrounding placement.
+func trickDots(n, total int, accent lipgloss.TerminalColor) string {
 	filled := lipgloss.NewStyle().Foreground(accent)
 	empty := theme.Current.Muted
 	s := ""
-	for i := 0; i < 5; i++ {
+	for i := 0; i < total; i++ {
 		if i < n {
 			s += filled.Render("●")
 		} else {
@@ -1889,9 +2207,9 @@ func trickDots(n int, accent lipgloss.TerminalColor) string {
 // panelTricks stacks the "Tricks" label directly above the pip tracker and
 // centers the pair as one unit, so the label sits centered over the bubbles
 // (rather than each centering independently and ending up a column apart).
-func panelTricks(n int, accent lipgloss.TerminalColor) string {
+func panelTricks(n, total int, accent lipgloss.TerminalColor) string {
 	label := theme.Current.Muted.Render("Tricks")
-	block := lipgloss.JoinVertical(lipgloss.Center, label, trickDots(n, accent))
+	block := lipgloss.JoinVertical(lipgloss.Center, label, trickDots(n, total, accent))
 	return lipgloss.PlaceHorizontal(panelInnerWidth, lipgloss.Center, block)
 }
 
@@ -1900,23 +2218,28 @@ func panelTricks(n int, accent lipgloss.TerminalColor) string {
 // so it flanks the right side of the table. Global state lives in the banner.
 func (g *GamePlay) renderOppCard() string {
 	scores := g.game.Scores()
-	var oppTricks int
-	if r := g.game.Round(); r != nil {
-		oppTricks = r.TricksWon(1) + r.TricksWon(3)
-	}
-
-	scoreStyle := lipgloss.NewStyle().Foreground(theme.ColRed).Bold(true)
-	scoreStr := fmt.Sprintf("%d pts", scores[1])
-	if g.scoreAnimFrames > 0 && g.scoreDelta[1] > 0 {
-		scoreStr = fmt.Sprintf("%d (+%d) pts", scores[1], g.scoreDelta[1])
-		scoreStyle = scoreStyle.Background(theme.ColRed).Foreground(lipgloss.Color("#FFF"))
-	}
-
-	body := []string{
-		panelCenter(scoreStyle, scoreStr),
-		"",
-		panelTricks(oppTricks, theme.ColRed),
+	oppTricks := g.teamTricks(true)
+
+	// One line per opposing team, named when there is more than one. A team
+	// of several players lists them above its score.
+	var body []string
+	for _, team := range g.opponentTeams() {
+		scoreStyle := lipgloss.NewStyle().Foreground(theme.ColRed).Bold(true)
+		scoreStr := fmt.Sprintf("%d pts", scores[team])
+		if g.scoreAnimFrames > 0 && g.scoreDelta[team] > 0 {
+			scoreStr = fmt.Sprintf("%d (+%d) pts", scores[team], g.scoreDelta[team])
+			scoreStyle = scoreStyle.Background(theme.ColRed).Foreground(lipgloss.Color("#FFF"))
+		}
+		if members := g.teamMembers(team); g.game.NumTeams() > 2 && len(members) == 1 {
+			scoreStr = fmt.Sprintf("%s %d", members[0], scores[team])
+		} else if g.game.NumTeams() > 2 {
+			for _, name := range members {
+				body = append(body, panelCenter(scoreStyle, name))
+			}
+		}
+		body = append(body, panelCenter(scoreStyle, scoreStr))
 	}
+	body = append(body, "", panelTricks(oppTricks, g.game.Rules().TrickCount(), theme.ColRed))
 
 	return boxFrame("OPP", theme.ColRed, lipgloss.JoinVertical(lipgloss.Center, body...), panelInnerWidth)
 }
@@ -1959,6 +2282,9 @@ func (g *GamePlay) renderContractBanner(maxWidth int) string {
 	} else {
 		parts = append(parts, theme.Current.Muted.Italic(true).Render("bidding…"))
 	}
+	if g.duplicate != nil {
+		parts = append(parts, theme.Current.Muted.Render(fmt.Sprintf("Dup %+d", g.duplicate.total())))
+	}
 
 	return lipgloss.NewStyle().MaxWidth(maxWidth).Render(strings.Join(parts, sep))
 }
@@ -1968,17 +2294,17 @@ func (g *GamePlay) renderContractBanner(maxWidth int) string {
 // key facts (scores, tricks, trump, contract, round) into one centered line.
 func (g *GamePlay) renderScoreBar() string {
 	scores := g.game.Scores()
-	var youTr, oppTr int
-	if r := g.game.Round(); r != nil {
-		youTr = r.TricksWon(0) + r.TricksWon(2)
-		oppTr = r.TricksWon(1) + r.TricksWon(3)
-	}
+	youTr, oppTr := g.teamTricks(false), g.teamTricks(true)
 
-	parts := []string{
-		theme.Current.TeamYou.Render(fmt.Sprintf("YOU %d", scores[0])),
-		theme.Current.TeamOpp.Render(fmt.Sprintf("OPP %d", scores[1])),
-		theme.Current.Muted.Render(fmt.Sprintf("Tricks %d-%d", youTr, oppTr)),
+	parts := []string{theme.Current.TeamYou.Render(fmt.Sprintf("YOU %d", scores[g.game.Team(g.humanPlayer)]))}
+	for _, team := range g.opponentTeams() {
+		label := "OPP"
+		if g.game.NumTeams() > 2 {
+			label = strings.ToUpper(g.teamName(team))
+		}
+		parts = append(parts, theme.Current.TeamOpp.Render(fmt.Sprintf("%s %d", label, scores[team])))
 	}
+	parts = append(parts, theme.Current.Muted.Render(fmt.Sprintf("Tricks %d-%d", youTr, oppTr)))
 
 	if g.tableView.Trump != engine.NoSuit {
 		trumpStyle := theme.Current.CardBlack
@@ -1997,6 +2323,9 @@ func (g *GamePlay) renderScoreBar() string {
 	}
 
 	parts = append(parts, theme.Current.Muted.Render(fmt.Sprintf("Rd %d", g.tableView.RoundNumber)))
+	if g.duplicate != nil {
+		parts = append(parts, theme.Current.Muted.Render(fmt.Sprintf("Dup %+d", g.duplicate.total())))
+	}
 
 	return strings.Join(parts, theme.Current.Muted.Render("  •  "))
 }
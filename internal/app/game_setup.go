@@ -4,6 +4,7 @@ import (
 	"github.com/BrandonDedolph/euchre/internal/ai"
 	"github.com/BrandonDedolph/euchre/internal/ui/components"
 	"github.com/BrandonDedolph/euchre/internal/ui/theme"
+	"github.com/BrandonDedolph/euchre/internal/variants"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -11,22 +12,29 @@ import (
 // GameSettings is the payload passed from the setup screen to game play,
 // describing the rule toggles chosen by the player.
 type GameSettings struct {
-	Variant        string
-	StickTheDealer bool
-	DefendAlone    bool
-	Difficulty     ai.Difficulty // opponent AI skill level (defaults to Medium)
-	Tutorial       bool          // enable the interactive coach (random hand + per-move tips)
+	Variant         string        `json:"variant"`
+	StickTheDealer  bool          `json:"stickTheDealer"`
+	DefendAlone     bool          `json:"defendAlone"`
+	SevensAndEights bool          `json:"sevensAndEights,omitempty"` // play with the 32-card deck, 7 through Ace
+	Difficulty      ai.Difficulty `json:"difficulty"`                // opponent AI skill level (defaults to Medium)
+	Strategy        string        `json:"strategy,omitempty"`        // opponent AI strategy name ("" = ai.DefaultStrategy)
+	Tutorial        bool          `json:"tutorial"`                  // enable the interactive coach (random hand + per-move tips)
+	Seed            int64         `json:"seed"`                      // deal seed for a reproducible game (0 = pick one at random)
+	Duplicate       bool          `json:"duplicate,omitempty"`       // compare each deal with the AI playing the same cards
 }
 
 // GameSetup is the game setup screen
 type GameSetup struct {
-	menu           *components.Menu
-	variant        string
-	stickTheDealer bool
-	defendAlone    bool
-	difficulty     ai.Difficulty
-	width          int
-	height         int
+	menu            *components.Menu
+	variant         string
+	stickTheDealer  bool
+	defendAlone     bool
+	sevensAndEights bool
+	difficulty      ai.Difficulty
+	strategy        string
+	duplicate       bool
+	width           int
+	height          int
 }
 
 // NewGameSetup creates a new game setup screen
@@ -48,10 +56,22 @@ func NewGameSetup() *GameSetup {
 			Label:       "Defend Alone: Off",
 			Description: "Allow defenders to go alone for 4 points on euchre",
 		},
+		{
+			Label:       "Sevens and Eights: Off",
+			Description: "Add the 7s and 8s for a 32-card deck",
+		},
 		{
 			Label:       "AI Difficulty: Medium",
 			Description: "Skill level of the computer opponents",
 		},
+		{
+			Label:       "AI Strategy: " + ai.DefaultStrategy,
+			Description: "How the computer opponents think",
+		},
+		{
+			Label:       "Duplicate Challenge: Off",
+			Description: "Compare each deal with the AI playing your cards",
+		},
 		{
 			Label:       "Back to Menu",
 			Description: "Return to the main menu",
@@ -62,6 +82,7 @@ func NewGameSetup() *GameSetup {
 		menu:       components.NewMenu("", items),
 		variant:    "Standard",
 		difficulty: ai.DifficultyMedium,
+		strategy:   ai.DefaultStrategy,
 	}
 }
 
@@ -97,13 +118,25 @@ func (g *GameSetup) handleSelect() (tea.Model, tea.Cmd) {
 	switch g.menu.Selected {
 	case 0: // Start Game
 		return g, NavigateWithData(ScreenGamePlay, GameSettings{
-			Variant:        g.variant,
-			StickTheDealer: g.stickTheDealer,
-			DefendAlone:    g.defendAlone,
-			Difficulty:     g.difficulty,
+			Variant:         g.variant,
+			StickTheDealer:  g.stickTheDealer,
+			DefendAlone:     g.defendAlone,
+			SevensAndEights: g.sevensAndEights,
+			Difficulty:      g.difficulty,
+			Strategy:        g.strategy,
+			Duplicate:       g.duplicate,
 		})
-	case 1: // Variant toggle
-		// TODO: Cycle through variants (only Standard exists for now)
+	case 1: // Variant cycles through the registered variants
+		names := variants.List()
+		next := 0
+		for i, name := range names {
+			if name == g.variant {
+				next = (i + 1) % len(names)
+			}
+		}
+		if len(names) > 0 {
+			g.setVariant(names[next])
+		}
 	case 2: // Stick the Dealer toggle
 		g.stickTheDealer = !g.stickTheDealer
 		if g.stickTheDealer {
@@ -118,23 +151,66 @@ func (g *GameSetup) handleSelect() (tea.Model, tea.Cmd) {
 		} else {
 			g.menu.Items[3].Label = "Defend Alone: Off"
 		}
-	case 4: // AI Difficulty cycle (Easy -> Medium -> Hard -> Easy)
+	case 4: // Sevens and Eights toggle
+		g.sevensAndEights = !g.sevensAndEights
+		if g.sevensAndEights {
+			g.menu.Items[4].Label = "Sevens and Eights: On"
+		} else {
+			g.menu.Items[4].Label = "Sevens and Eights: Off"
+		}
+	case 5: // AI Difficulty cycle (Easy -> Medium -> Hard -> Expert -> Easy)
 		switch g.difficulty {
 		case ai.DifficultyEasy:
 			g.difficulty = ai.DifficultyMedium
 		case ai.DifficultyMedium:
 			g.difficulty = ai.DifficultyHard
-		default: // Hard (or any unexpected value) wraps back to Easy
+		case ai.DifficultyHard:
+			g.difficulty = ai.DifficultyExpert
+		default: // Expert (or any unexpected value) wraps back to Easy
 			g.difficulty = ai.DifficultyEasy
 		}
-		g.menu.Items[4].Label = "AI Difficulty: " + g.difficulty.String()
-	case 5: // Back
+		g.menu.Items[5].Label = "AI Difficulty: " + g.difficulty.String()
+	case 6: // AI Strategy cycles through the registered strategies
+		names := ai.Strategies()
+		next := 0
+		for i, name := range names {
+			if name == g.strategy {
+				next = (i + 1) % len(names)
+			}
+		}
+		if len(names) > 0 {
+			g.setStrategy(names[next])
+		}
+	case 7: // Duplicate Challenge toggle
+		g.duplicate = !g.duplicate
+		if g.duplicate {
+			g.menu.Items[7].Label = "Duplicate Challenge: On"
+		} else {
+			g.menu.Items[7].Label = "Duplicate Challenge: Off"
+		}
+	case 8: // Back
 		return g, Navigate(ScreenMainMenu)
 	}
 
 	return g, nil
 }
 
+// setVariant selects the variant to play and updates its menu label and
+// description
+func (g *GameSetup) setVariant(name string) {
+	g.variant = name
+	g.menu.Items[1].Label = "Variant: " + name
+	if v, ok := variants.Get(name); ok {
+		g.menu.Items[1].Description = v.Description()
+	}
+}
+
+// setStrategy selects the opponents' AI strategy and updates its menu label
+func (g *GameSetup) setStrategy(name string) {
+	g.strategy = name
+	g.menu.Items[6].Label = "AI Strategy: " + name
+}
+
 // View implements tea.Model
 func (g *GameSetup) View() string {
 	width := g.width
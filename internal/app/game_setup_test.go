@@ -4,13 +4,15 @@ import (
 	"testing"
 
 	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/ai/mcts"
 	"github.com/BrandonDedolph/euchre/internal/ai/rule_based"
+	"github.com/BrandonDedolph/euchre/internal/engine"
 )
 
 // selectDifficulty drives the setup menu to the AI Difficulty item and selects
 // it once, returning the resulting GameSetup state.
 func cycleDifficulty(g *GameSetup) {
-	g.menu.Selected = 4 // AI Difficulty item
+	g.menu.Selected = 5 // AI Difficulty item
 	g.handleSelect()
 }
 
@@ -19,7 +21,7 @@ func TestGameSetupDifficultyDefaultsToMedium(t *testing.T) {
 	if g.difficulty != ai.DifficultyMedium {
 		t.Fatalf("default difficulty = %v, want Medium", g.difficulty)
 	}
-	if got := g.menu.Items[4].Label; got != "AI Difficulty: Medium" {
+	if got := g.menu.Items[5].Label; got != "AI Difficulty: Medium" {
 		t.Fatalf("default label = %q, want %q", got, "AI Difficulty: Medium")
 	}
 }
@@ -27,12 +29,13 @@ func TestGameSetupDifficultyDefaultsToMedium(t *testing.T) {
 func TestGameSetupDifficultyCycles(t *testing.T) {
 	g := NewGameSetup()
 
-	// Medium -> Hard -> Easy -> Medium
+	// Medium -> Hard -> Expert -> Easy -> Medium
 	wants := []struct {
 		diff  ai.Difficulty
 		label string
 	}{
 		{ai.DifficultyHard, "AI Difficulty: Hard"},
+		{ai.DifficultyExpert, "AI Difficulty: Expert"},
 		{ai.DifficultyEasy, "AI Difficulty: Easy"},
 		{ai.DifficultyMedium, "AI Difficulty: Medium"},
 	}
@@ -42,7 +45,7 @@ func TestGameSetupDifficultyCycles(t *testing.T) {
 		if g.difficulty != w.diff {
 			t.Errorf("cycle %d: difficulty = %v, want %v", i, g.difficulty, w.diff)
 		}
-		if got := g.menu.Items[4].Label; got != w.label {
+		if got := g.menu.Items[5].Label; got != w.label {
 			t.Errorf("cycle %d: label = %q, want %q", i, got, w.label)
 		}
 	}
@@ -82,7 +85,7 @@ func TestDifficultyReachesAIPlayers(t *testing.T) {
 		if p == nil {
 			continue // the human's seat has no AI
 		}
-		got := p.(*rule_based.AI).Difficulty()
+		got := p.(*ai.StrategyPlayer).Strategy().(*rule_based.Strategy).Difficulty()
 		if got != ai.DifficultyHard {
 			t.Errorf("AI player %d difficulty = %v, want Hard", i, got)
 		}
@@ -92,3 +95,133 @@ func TestDifficultyReachesAIPlayers(t *testing.T) {
 		t.Fatal("no non-nil AI players to verify")
 	}
 }
+
+func TestSeedReproducesTheDeal(t *testing.T) {
+	a := NewGamePlayWithSettings(GameSettings{Variant: "Standard", Seed: 2024})
+	b := NewGamePlayWithSettings(GameSettings{Variant: "Standard", Seed: 2024})
+
+	if a.game.Seed() != 2024 {
+		t.Fatalf("game seed = %d, want 2024", a.game.Seed())
+	}
+	for p := 0; p < 4; p++ {
+		ha, hb := a.game.Hand(p), b.game.Hand(p)
+		for i := range ha {
+			if ha[i] != hb[i] {
+				t.Fatalf("seat %d dealt differently from the same seed: %v vs %v", p, ha, hb)
+			}
+		}
+	}
+	if a.game.TurnedCard() != b.game.TurnedCard() {
+		t.Errorf("turned card differs from the same seed: %v vs %v", a.game.TurnedCard(), b.game.TurnedCard())
+	}
+}
+
+func TestUnseededGameStillRecordsASeed(t *testing.T) {
+	gp := NewGamePlayWithSettings(GameSettings{Variant: "Standard"})
+	if gp.game.Seed() == 0 {
+		t.Error("a game started without a seed should pick one so it can be replayed")
+	}
+}
+
+func TestGameSetupStrategyCyclesAndReachesAIPlayers(t *testing.T) {
+	g := NewGameSetup()
+	if g.strategy != ai.DefaultStrategy {
+		t.Fatalf("default strategy = %q, want %q", g.strategy, ai.DefaultStrategy)
+	}
+
+	// Cycle until the ISMCTS strategy comes up.
+	for i := 0; g.strategy != mcts.StrategyName; i++ {
+		if i > len(ai.Strategies()) {
+			t.Fatalf("cycling never reached %q; registered: %v", mcts.StrategyName, ai.Strategies())
+		}
+		g.menu.Selected = 6 // AI Strategy item
+		g.handleSelect()
+	}
+	if got := g.menu.Items[6].Label; got != "AI Strategy: "+mcts.StrategyName {
+		t.Errorf("label = %q after choosing %q", got, mcts.StrategyName)
+	}
+
+	g.menu.Selected = 0 // Start Game
+	_, cmd := g.handleSelect()
+	settings := cmd().(NavigateMsg).Data.(GameSettings)
+	gp := NewGamePlayWithSettings(settings)
+	for i, p := range gp.aiPlayers {
+		if p == nil {
+			continue
+		}
+		if _, ok := p.(*mcts.Player); !ok {
+			t.Errorf("AI player %d is %T, want an ISMCTS player", i, p)
+		}
+	}
+}
+
+func TestGameSetupVariantCyclesToBritish(t *testing.T) {
+	g := NewGameSetup()
+	g.menu.Selected = 1 // Variant item
+	g.handleSelect()
+	if g.variant != "Two-Handed" {
+		t.Fatalf("variant = %q after one cycle, want Two-Handed", g.variant)
+	}
+	g.handleSelect()
+	if g.variant != "British" {
+		t.Fatalf("variant = %q after wrapping round, want British", g.variant)
+	}
+	if got := g.menu.Items[1].Label; got != "Variant: British" {
+		t.Errorf("label = %q", got)
+	}
+
+	g.menu.Selected = 0 // Start Game
+	_, cmd := g.handleSelect()
+	gp := NewGamePlayWithSettings(cmd().(NavigateMsg).Data.(GameSettings))
+	if _, ok := gp.game.DeckConfig().(engine.BritishDeckConfig); !ok {
+		t.Errorf("game deck = %T, want the British deck", gp.game.DeckConfig())
+	}
+	if got := len(gp.game.Round().Pack()); got != 25 {
+		t.Errorf("first deal came from %d cards, want 25", got)
+	}
+
+	g.menu.Selected = 1
+	g.handleSelect()
+	if g.variant != "Cutthroat" {
+		t.Fatalf("variant = %q after cycling past British, want Cutthroat", g.variant)
+	}
+	g.handleSelect()
+	if g.variant != "Six-Handed" {
+		t.Fatalf("variant = %q after cycling past Cutthroat, want Six-Handed", g.variant)
+	}
+	g.handleSelect()
+	if g.variant != "Six-Handed 3v3" {
+		t.Fatalf("variant = %q after cycling past Six-Handed, want Six-Handed 3v3", g.variant)
+	}
+	g.handleSelect()
+	if g.variant != "Standard" {
+		t.Errorf("variant = %q after cycling past Six-Handed 3v3, want Standard", g.variant)
+	}
+}
+
+func TestGameSetupSevensAndEights(t *testing.T) {
+	g := NewGameSetup()
+	g.menu.Selected = 4 // Sevens and Eights item
+	g.handleSelect()
+	if got := g.menu.Items[4].Label; got != "Sevens and Eights: On" {
+		t.Fatalf("label = %q after toggling", got)
+	}
+
+	g.menu.Selected = 0 // Start Game
+	_, cmd := g.handleSelect()
+	settings := cmd().(NavigateMsg).Data.(GameSettings)
+	if !settings.SevensAndEights {
+		t.Fatal("settings don't carry the 7s and 8s")
+	}
+	gp := NewGamePlayWithSettings(settings)
+	if got := len(gp.game.Round().Pack()); got != 32 {
+		t.Errorf("first deal came from %d cards, want 32", got)
+	}
+
+	// British adds the Benny to the 24-card deck and ignores the toggle.
+	settings.Variant = "British"
+	gp = NewGamePlayWithSettings(settings)
+	if got := len(gp.game.Round().Pack()); got != 25 {
+		t.Errorf("British deal came from %d cards, want 25", got)
+	}
+}
@@ -34,7 +34,7 @@ func rowOf(view, marker string) int { return posOf(view, marker).row }
 // state at a fixed terminal size so View() exercises the real layout path.
 func renderableGamePlay(t *testing.T, tutorial bool, w, h int) *GamePlay {
 	t.Helper()
-	g := newGamePlay(rulesFromVariant(variantFromSettings(GameSettings{Variant: "Standard"})), tutorial, ai.DifficultyMedium)
+	g := newGamePlay(variantFromSettings(GameSettings{Variant: "Standard"}), tutorial, ai.DefaultStrategy, ai.DifficultyMedium, 0)
 	g.isShuffling = false
 	g.isDealing = false
 	g.width = w
@@ -88,7 +88,7 @@ func TestGamePlayLayoutStable(t *testing.T) {
 
 	setActions := func(a [4]string) func() {
 		return func() {
-			g.playerAction = a
+			g.playerAction = a[:]
 			g.updateTableView()
 		}
 	}
@@ -124,7 +124,7 @@ func TestGamePlaySeatActionsStable(t *testing.T) {
 
 	setActions := func(a [4]string) func() {
 		return func() {
-			g.playerAction = a
+			g.playerAction = a[:]
 			g.updateTableView()
 		}
 	}
@@ -137,7 +137,7 @@ func TestGamePlaySeatActionsStable(t *testing.T) {
 
 	// The action label should actually render near its seat. "West (0)" is the
 	// West seat label; "passes" must appear on the line directly below it.
-	g.playerAction = [4]string{"", "passes", "", ""}
+	g.playerAction = []string{"", "passes", "", ""}
 	g.updateTableView()
 	view := g.View()
 	westRow := rowOf(view, "West")
@@ -167,7 +167,7 @@ func TestGamePlayTutorialCoachLayoutStable(t *testing.T) {
 				"are void in that suit and can trump it, costing you a likely trick."
 		},
 		"seat actions plus coach": func() {
-			g.playerAction = [4]string{"orders up", "passes", "", "calls ♥"}
+			g.playerAction = []string{"orders up", "passes", "", "calls ♥"}
 			g.updateTableView()
 		},
 	})
@@ -180,7 +180,7 @@ func TestGamePlayCompactLayoutStable(t *testing.T) {
 
 	assertAnchorsStable(t, g, []string{"Partner", "YOU", "OPP"}, map[string]func(){
 		"seat actions appear": func() {
-			g.playerAction = [4]string{"", "passes", "orders up", ""}
+			g.playerAction = []string{"", "passes", "orders up", ""}
 			g.updateTableView()
 		},
 		"long status message": func() {
@@ -357,3 +357,89 @@ func updateModel[T tea.Model](m T, msg tea.Msg) (T, tea.Cmd) {
 	updated, cmd := m.Update(msg)
 	return updated.(T), cmd
 }
+
+// TestCutthroatTableSeatsThree verifies a three-handed game leaves the partner
+// seat empty and keeps the same footprint as the four-handed table.
+func TestCutthroatTableSeatsThree(t *testing.T) {
+	standard := renderableGamePlay(t, false, fullLayoutWidth, 40).View()
+
+	g := newGamePlay(variantFromSettings(GameSettings{Variant: "Cutthroat"}), false, ai.DefaultStrategy, ai.DifficultyMedium, 0)
+	g.isShuffling = false
+	g.isDealing = false
+	g.width = fullLayoutWidth
+	g.height = 40
+	g.updateTableView()
+	view := g.View()
+
+	if strings.Contains(view, "Partner") {
+		t.Errorf("three-handed table shows a partner seat:\n%s", view)
+	}
+	for _, name := range []string{"West", "East", "YOU"} {
+		if rowOf(view, name) < 0 {
+			t.Errorf("three-handed table is missing %q", name)
+		}
+	}
+	if got, want := lipgloss.Height(view), lipgloss.Height(standard); got != want {
+		t.Errorf("three-handed view is %d rows, want %d like the four-handed table", got, want)
+	}
+}
+
+// TestTwoHandedTableShowsStacks verifies a two-handed game seats the opponent
+// across the table with their face-up stack cards showing, and keeps the
+// same footprint as the four-handed table.
+func TestTwoHandedTableShowsStacks(t *testing.T) {
+	standard := renderableGamePlay(t, false, fullLayoutWidth, 40).View()
+
+	g := newGamePlay(variantFromSettings(GameSettings{Variant: "Two-Handed"}), false, ai.DefaultStrategy, ai.DifficultyMedium, 0)
+	g.isShuffling = false
+	g.isDealing = false
+	g.width = fullLayoutWidth
+	g.height = 40
+	g.updateTableView()
+	view := g.View()
+
+	for _, name := range []string{"West", "East", "Partner"} {
+		if strings.Contains(view, name) {
+			t.Errorf("two-handed table shows a %s seat", name)
+		}
+	}
+	if rowOf(view, "Opponent") < 0 {
+		t.Error("two-handed table is missing the opponent")
+	}
+	if got := len(g.tableView.Stacks[1]); got != 3 {
+		t.Errorf("opponent shows %d face-up stack cards, want 3", got)
+	}
+	if rowOf(view, "+3 face down") < 0 {
+		t.Error("your face-down stack cards are not mentioned")
+	}
+	if got, want := lipgloss.Height(view), lipgloss.Height(standard); got != want {
+		t.Errorf("two-handed view is %d rows, want %d like the four-handed table", got, want)
+	}
+}
+
+// TestSixHandedTableSeatsSix verifies a six-handed game draws every seat,
+// two to a side with the partner across, and keeps the same footprint as
+// the four-handed table.
+func TestSixHandedTableSeatsSix(t *testing.T) {
+	standard := renderableGamePlay(t, false, fullLayoutWidth, 40).View()
+
+	g := newGamePlay(variantFromSettings(GameSettings{Variant: "Six-Handed"}), false, ai.DefaultStrategy, ai.DifficultyMedium, 0)
+	g.isShuffling = false
+	g.isDealing = false
+	g.width = fullLayoutWidth
+	g.height = 40
+	g.updateTableView()
+	view := g.View()
+
+	for _, name := range []string{"West", "Far West", "Partner", "Far East", "East", "YOU"} {
+		if rowOf(view, name) < 0 {
+			t.Errorf("six-handed table is missing %q:\n%s", name, view)
+		}
+	}
+	if rowOf(view, "Far West") >= rowOf(view, "West (") {
+		t.Error("Far West is not drawn above West")
+	}
+	if got, want := lipgloss.Height(view), lipgloss.Height(standard); got != want {
+		t.Errorf("six-handed view is %d rows, want %d like the four-handed table", got, want)
+	}
+}
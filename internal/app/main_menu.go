@@ -15,30 +15,56 @@ type MainMenu struct {
 	height int
 }
 
+// Main menu item labels. Items are matched by label because "Resume Game"
+// and "Replay Hands" only appear when there is something to resume or
+// replay, which shifts the other indexes.
+const (
+	menuResumeGame = "Resume Game"
+	menuPlayGame   = "Play Game"
+	menuReplay     = "Replay Hands"
+	menuLearn      = "Learn to Play"
+	menuTutorial   = "Interactive Tutorial"
+	menuQuickRef   = "Quick Reference"
+	menuQuit       = "Quit"
+)
+
 // NewMainMenu creates a new main menu
 func NewMainMenu() *MainMenu {
-	items := []components.MenuItem{
-		{
-			Label:       "Play Game",
-			Description: "Start a new game against AI opponents",
-		},
+	var items []components.MenuItem
+	if hasSavedGame() {
+		items = append(items, components.MenuItem{
+			Label:       menuResumeGame,
+			Description: "Continue the game you left, right where you stopped",
+		})
+	}
+	items = append(items, components.MenuItem{
+		Label:       menuPlayGame,
+		Description: "Start a new game against AI opponents",
+	})
+	if hasHandHistories() {
+		items = append(items, components.MenuItem{
+			Label:       menuReplay,
+			Description: "Step through the hands of your last game, every card face-up",
+		})
+	}
+	items = append(items, []components.MenuItem{
 		{
-			Label:       "Learn to Play",
+			Label:       menuLearn,
 			Description: "Guided lessons on the rules and strategy",
 		},
 		{
-			Label:       "Interactive Tutorial",
+			Label:       menuTutorial,
 			Description: "Play a real, randomly-dealt hand with a coach guiding each move",
 		},
 		{
-			Label:       "Quick Reference",
+			Label:       menuQuickRef,
 			Description: "View rules and card rankings",
 		},
 		{
-			Label:       "Quit",
+			Label:       menuQuit,
 			Description: "Exit the application",
 		},
-	}
+	}...)
 
 	return &MainMenu{
 		menu: components.NewMenu("", items),
@@ -79,16 +105,34 @@ func (m *MainMenu) handleSelect() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
-	switch m.menu.Selected {
-	case 0: // Play Game
+	switch item.Label {
+	case menuResumeGame:
+		save, err := loadSavedGame()
+		if err != nil {
+			// Unreadable save (corrupt or from an incompatible version): say so
+			// in place rather than dropping the player into a broken game.
+			item.Disabled = true
+			item.Description = "The saved game could not be loaded"
+			return m, nil
+		}
+		return m, NavigateWithData(ScreenGamePlay, save)
+	case menuPlayGame:
 		return m, Navigate(ScreenGameSetup)
-	case 1: // Learn to Play
+	case menuReplay:
+		hands, err := LoadLastHandHistories()
+		if err != nil {
+			item.Disabled = true
+			item.Description = "The recorded hands could not be loaded"
+			return m, nil
+		}
+		return m, NavigateWithData(ScreenReplay, hands)
+	case menuLearn:
 		return m, Navigate(ScreenLearningJourney)
-	case 2: // Interactive Tutorial — a real random hand with coaching
+	case menuTutorial: // a real random hand with coaching
 		return m, NavigateWithData(ScreenGamePlay, GameSettings{Variant: "Standard", Tutorial: true, Difficulty: ai.DifficultyMedium})
-	case 3: // Quick Reference
+	case menuQuickRef:
 		return m, Navigate(ScreenQuickReference)
-	case 4: // Quit
+	case menuQuit:
 		return m, Quit()
 	}
 
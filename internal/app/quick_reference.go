@@ -271,6 +271,7 @@ func (q *QuickReference) renderBasicRulesPanel() string {
 	// Card deck info
 	deckHeader := theme.Current.Secondary.Bold(true).Render("The Deck")
 	deckInfo := "9  10  J  Q  K  A   of each suit (24 cards total)"
+	deckOption := theme.Current.Muted.Render("Optional: add the 7s and 8s for 32 cards")
 
 	return lipgloss.JoinVertical(lipgloss.Center,
 		header,
@@ -281,6 +282,7 @@ func (q *QuickReference) renderBasicRulesPanel() string {
 		"",
 		deckHeader,
 		deckInfo,
+		deckOption,
 	)
 }
 
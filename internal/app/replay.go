@@ -0,0 +1,248 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/ui/components"
+	"github.com/BrandonDedolph/euchre/internal/ui/theme"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Replay steps through recorded hands one decision at a time with every hand
+// face-up: the deal, each bid, the dealer's discard and each card played.
+type Replay struct {
+	hands []engine.HandHistory
+	hand  int // index into hands
+	step  int // number of recorded actions applied; 0 = just dealt
+
+	round     *engine.Round // state after step actions
+	err       error         // set when the recorded hand can't be replayed
+	tableView *components.TableView
+	width     int
+	height    int
+}
+
+// NewReplay creates a replay viewer positioned at the deal of the first hand.
+func NewReplay(hands []engine.HandHistory) *Replay {
+	r := &Replay{
+		hands:     hands,
+		tableView: components.NewTableView(),
+	}
+	r.seek(0, 0)
+	return r
+}
+
+// Init implements tea.Model
+func (r *Replay) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model
+func (r *Replay) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		r.width = msg.Width
+		r.height = msg.Height
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc":
+			return r, Navigate(ScreenMainMenu)
+		case "right", "l", " ":
+			r.seek(r.hand, r.step+1)
+		case "left", "h":
+			r.seek(r.hand, r.step-1)
+		case "home", "g":
+			r.seek(r.hand, 0)
+		case "end", "G":
+			r.seek(r.hand, r.stepCount())
+		case "n", "down", "j":
+			r.seek(r.hand+1, 0)
+		case "p", "up", "k":
+			r.seek(r.hand-1, 0)
+		}
+	}
+
+	return r, nil
+}
+
+// stepCount returns how many recorded actions the current hand has.
+func (r *Replay) stepCount() int {
+	if len(r.hands) == 0 {
+		return 0
+	}
+	return len(r.hands[r.hand].Actions)
+}
+
+// seek moves to the given hand and step, clamping both to what was recorded,
+// and rebuilds the round state by replaying the hand from its deal.
+func (r *Replay) seek(hand, step int) {
+	if len(r.hands) == 0 {
+		return
+	}
+	hand = max(0, min(hand, len(r.hands)-1))
+	r.hand = hand
+	r.step = max(0, min(step, r.stepCount()))
+	r.round, r.err = r.hands[r.hand].Replay(r.step)
+	if r.err == nil {
+		r.updateTableView()
+	}
+}
+
+// updateTableView mirrors the replayed round onto the table, face-up.
+func (r *Replay) updateTableView() {
+	round := r.round
+	// Hands from games of different sizes get a table seated to match.
+	if len(r.tableView.PlayerHands) != round.NumPlayers() {
+		r.tableView = components.NewTableViewFor(round.NumPlayers(), round.NumTeams())
+	}
+	tv := r.tableView
+
+	tv.Trump = round.Trump()
+	tv.TurnedCard = round.TurnedCard()
+	tv.Dealer = round.Dealer()
+	tv.CurrentPlayer = round.CurrentPlayer()
+	tv.Maker = round.Maker()
+	tv.MakerAlone = round.IsAlone()
+	tv.RoundNumber = r.hand + 1
+	tv.CurrentTrick = round.CurrentTrick()
+	tv.TrickWinner = -1
+
+	hands := make([][]engine.Card, round.NumPlayers())
+	for i := range hands {
+		hands[i] = round.Hand(i)
+		tv.PlayerHands[i] = len(hands[i])
+		tv.TricksWon[i] = round.TricksWon(i)
+	}
+	tv.FaceUpHands = hands
+
+	// On the step that completes a trick, show the finished trick with its
+	// winner crowned rather than whatever the engine has moved on to.
+	actions := r.hands[r.hand].Actions[:r.step]
+	if n := len(actions); n > 0 && actions[n-1].Type == engine.ActionPlayCard {
+		if tricks := round.TrickHistory(); len(tricks) > 0 {
+			last := tricks[len(tricks)-1]
+			for _, pc := range last.Cards {
+				if pc.Card == *actions[n-1].Card {
+					tv.CurrentTrick = last.Cards
+					tv.TrickWinner = last.Winner
+					break
+				}
+			}
+		}
+	}
+
+	// Seat labels show the bidding until the first card is led.
+	tv.PlayerActions = make([]string, round.NumPlayers())
+	for _, a := range actions {
+		if a.Type == engine.ActionPlayCard {
+			clear(tv.PlayerActions)
+			break
+		}
+		tv.PlayerActions[a.Player] = replayActionLabel(a)
+	}
+}
+
+// replayActionLabel is the short seat label for a recorded action.
+func replayActionLabel(a engine.ActionRecord) string {
+	var label string
+	switch a.Type {
+	case engine.ActionPass:
+		label = "passes"
+	case engine.ActionOrderUp:
+		label = "orders up"
+	case engine.ActionCallTrump:
+		label = "calls " + a.Suit.Symbol()
+	case engine.ActionDefendAlone:
+		label = "defends alone"
+	case engine.ActionDiscard:
+		label = "discards " + a.Card.String()
+	case engine.ActionPlayCard:
+		label = "plays " + a.Card.String()
+	}
+	if a.Alone {
+		label += " alone"
+	}
+	return label
+}
+
+// describeStep narrates the current step for the status line.
+func (r *Replay) describeStep() string {
+	names := r.tableView.PlayerNames
+	h := r.hands[r.hand]
+	if r.step == 0 {
+		return fmt.Sprintf("%s deals; %s is turned up", names[h.Dealer], h.TurnedCard)
+	}
+	a := h.Actions[r.step-1]
+	desc := names[a.Player] + ": " + replayActionLabel(a)
+	switch {
+	case r.round.IsMisdeal():
+		desc += " — misdeal, the hand is re-dealt"
+	case r.round.IsComplete():
+		res := r.round.Result()
+		if res.WasEuchred {
+			desc += fmt.Sprintf(" — makers euchred, defenders score %d", res.DefendPoints)
+		} else {
+			desc += fmt.Sprintf(" — makers take %d tricks and score %d", res.MakerTricks, res.MakerPoints)
+		}
+	}
+	return desc
+}
+
+// View implements tea.Model
+func (r *Replay) View() string {
+	width := r.width
+	height := r.height
+	if width == 0 {
+		width = 80
+	}
+	if height == 0 {
+		height = 30
+	}
+
+	title := theme.Current.Title.Render("Hand Replay")
+	help := theme.Current.Help.Render("←/→: Step • Home/End: Start/End • n/p: Next/Prev hand • Esc: Back")
+
+	var body string
+	switch {
+	case len(r.hands) == 0:
+		body = theme.Current.Muted.Render("No hands have been recorded yet.")
+	case r.err != nil:
+		body = theme.Current.Error.Render(fmt.Sprintf("This hand can't be replayed: %v", r.err))
+	default:
+		progress := theme.Current.Muted.Render(fmt.Sprintf("Hand %d of %d • Step %d of %d",
+			r.hand+1, len(r.hands), r.step, r.stepCount()))
+		tableStr := r.tableView.Render()
+		tableWidth := lipgloss.Width(tableStr)
+
+		you := theme.Current.Primary.Render(r.tableView.PlayerNames[0]) + " " +
+			theme.Current.Muted.Render(fmt.Sprintf("(%d)", r.round.TricksWon(0)))
+		if r.round.Dealer() == 0 {
+			you += " " + theme.Current.DealerBadge.Render("DEALER")
+		}
+		if r.round.CurrentPlayer() == 0 {
+			you += " ◀"
+		}
+		hand := components.RenderHand(r.round.Hand(0), -1, nil, r.round.Trump(), -1)
+		handStr := lipgloss.JoinVertical(lipgloss.Center, you, renderActionLine(r.tableView.PlayerActions[0]), hand)
+
+		body = lipgloss.JoinVertical(lipgloss.Center,
+			progress,
+			tableStr+lipgloss.PlaceHorizontal(tableWidth, lipgloss.Center, handStr),
+			"",
+			r.describeStep(),
+		)
+	}
+
+	content := lipgloss.JoinVertical(lipgloss.Center, title, "", body, "", help)
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, content)
+}
+
+// renderActionLine renders the bottom seat's action label, blank when empty.
+func renderActionLine(action string) string {
+	if action == "" {
+		return " "
+	}
+	return theme.Current.Muted.Render(action)
+}
@@ -0,0 +1,123 @@
+package app
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// playedHands plays one full seeded round and returns its hand history.
+func playedHands(t *testing.T) []engine.HandHistory {
+	t.Helper()
+	g := NewGamePlayWithSettings(GameSettings{Variant: "Standard", StickTheDealer: true, Seed: 9})
+	g.isShuffling, g.isDealing = false, false
+	for !g.game.IsOver() && !g.game.NeedsNewRound() {
+		driveToPlay(t, g, 0)
+		if err := g.game.ApplyAction(g.game.LegalActions()[0]); err != nil {
+			t.Fatalf("play failed: %v", err)
+		}
+	}
+	hands := g.game.HandHistories()
+	if len(hands) != 1 {
+		t.Fatalf("got %d hand histories, want 1", len(hands))
+	}
+	return hands
+}
+
+func TestReplayStepsForwardAndBack(t *testing.T) {
+	hands := playedHands(t)
+	r := NewReplay(hands)
+
+	if r.step != 0 || r.round.Phase() != engine.PhaseBidRound1 {
+		t.Fatalf("replay should open on the deal, got step %d in %s", r.step, r.round.Phase())
+	}
+	for seat, hand := range r.tableView.FaceUpHands {
+		if len(hand) != 5 {
+			t.Errorf("seat %d shows %d face-up cards, want 5", seat, len(hand))
+		}
+	}
+
+	right := tea.KeyMsg{Type: tea.KeyRight}
+	left := tea.KeyMsg{Type: tea.KeyLeft}
+	r.Update(right)
+	r.Update(right)
+	if r.step != 2 {
+		t.Fatalf("two steps forward should land on step 2, got %d", r.step)
+	}
+	r.Update(left)
+	if r.step != 1 {
+		t.Fatalf("a step back should land on step 1, got %d", r.step)
+	}
+	want, _ := hands[0].Replay(1)
+	if r.round.Phase() != want.Phase() || r.round.CurrentPlayer() != want.CurrentPlayer() {
+		t.Errorf("stepping back did not rewind the round")
+	}
+
+	r.Update(tea.KeyMsg{Type: tea.KeyEnd})
+	if r.step != len(hands[0].Actions) || !r.round.IsComplete() {
+		t.Fatalf("End should show the finished hand, got step %d", r.step)
+	}
+	r.Update(right)
+	if r.step != len(hands[0].Actions) {
+		t.Errorf("stepping past the last action should stay put, got %d", r.step)
+	}
+	if len(r.tableView.CurrentTrick) != 4 || r.tableView.TrickWinner < 0 {
+		t.Errorf("the final step should show the last trick crowned, got %v winner %d",
+			r.tableView.CurrentTrick, r.tableView.TrickWinner)
+	}
+}
+
+func TestReplayShowsEveryHandFaceUp(t *testing.T) {
+	hands := playedHands(t)
+	r := NewReplay(hands)
+	r.Update(tea.WindowSizeMsg{Width: 120, Height: 50})
+
+	view := r.View()
+	for seat, hand := range hands[0].Deal {
+		for _, c := range hand {
+			if !strings.Contains(view, c.Rank.String()) || !strings.Contains(view, c.Suit.Symbol()) {
+				t.Errorf("seat %d card %s missing from the replay view", seat, c)
+			}
+		}
+	}
+	if !strings.Contains(view, "is turned up") {
+		t.Error("the deal step should announce the turned card")
+	}
+}
+
+func TestLeavingAGameRecordsHandsForReplay(t *testing.T) {
+	t.Cleanup(deleteSavedGame)
+	g := NewGamePlayWithSettings(GameSettings{Variant: "Standard", Seed: 9})
+	g.isShuffling, g.isDealing = false, false
+	for g.game.Round().Phase() != engine.PhaseRoundEnd {
+		driveToPlay(t, g, 0)
+		if err := g.game.ApplyAction(g.game.LegalActions()[0]); err != nil {
+			t.Fatalf("play failed: %v", err)
+		}
+	}
+	g.saveOnExit()
+
+	hands, err := LoadLastHandHistories()
+	if err != nil {
+		t.Fatalf("loading recorded hands failed: %v", err)
+	}
+	if len(hands) != len(g.game.HandHistories()) {
+		t.Errorf("recorded %d hands, want %d", len(hands), len(g.game.HandHistories()))
+	}
+
+	m := NewMainMenu()
+	for i, item := range m.menu.Items {
+		if item.Label != menuReplay {
+			continue
+		}
+		m.menu.Selected = i
+		_, cmd := m.handleSelect()
+		if nav, ok := cmd().(NavigateMsg); !ok || nav.Screen != ScreenReplay {
+			t.Errorf("Replay Hands should open the replay screen")
+		}
+		return
+	}
+	t.Error("main menu should offer Replay Hands once a game's hands are recorded")
+}
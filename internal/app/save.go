@@ -0,0 +1,172 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// saveVersion is the version of the save-file envelope. The engine snapshot
+// inside it carries its own version (engine.SnapshotVersion).
+const saveVersion = 1
+
+// SavedGame is an in-progress game persisted when the player leaves GamePlay,
+// so it can be offered as "Resume Game" on the main menu.
+type SavedGame struct {
+	Version   int             `json:"version"`
+	Settings  GameSettings    `json:"settings"`
+	Game      *engine.Game    `json:"game"`
+	Duplicate []duplicateDeal `json:"duplicate,omitempty"` // duplicate challenge deals compared so far
+}
+
+// saveFilePath returns where the in-progress game is stored. It is a variable
+// so tests can point it at a temporary directory.
+var saveFilePath = func() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "euchre", "savegame.json"), nil
+}
+
+// writeSavedGame persists the game and the settings it was started with,
+// replacing any previous save.
+func writeSavedGame(save SavedGame) error {
+	path, err := saveFilePath()
+	if err != nil {
+		return err
+	}
+	save.Version = saveVersion
+	data, err := json.MarshalIndent(save, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	// Write to a temp file and rename so an interrupted save can't leave a
+	// truncated file behind.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// loadSavedGame reads the saved game, if any. It returns os.ErrNotExist
+// (wrapped) when there is nothing to resume.
+func loadSavedGame() (*SavedGame, error) {
+	path, err := saveFilePath()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var save SavedGame
+	if err := json.Unmarshal(data, &save); err != nil {
+		return nil, fmt.Errorf("reading saved game: %w", err)
+	}
+	if save.Version != saveVersion {
+		return nil, fmt.Errorf("unsupported save version %d", save.Version)
+	}
+	if save.Game == nil {
+		return nil, errors.New("saved game has no game state")
+	}
+	return &save, nil
+}
+
+// hasSavedGame reports whether a saved game file exists.
+func hasSavedGame() bool {
+	path, err := saveFilePath()
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return err == nil
+}
+
+// deleteSavedGame removes the saved game, if any.
+func deleteSavedGame() {
+	if path, err := saveFilePath(); err == nil {
+		_ = os.Remove(path)
+	}
+}
+
+// handsFilePath returns where the hand histories of the most recently left
+// game are kept for the replay viewer. Like saveFilePath it is a variable so
+// tests can redirect it.
+var handsFilePath = func() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "euchre", "lastgame-hands.json"), nil
+}
+
+// writeHandHistories records a game's completed hands for later replay,
+// replacing the previous game's.
+func writeHandHistories(hands []engine.HandHistory) error {
+	path, err := handsFilePath()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(hands, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// hasHandHistories reports whether a previous game's hands are available.
+func hasHandHistories() bool {
+	path, err := handsFilePath()
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return err == nil
+}
+
+// LoadLastHandHistories reads the hands of the last game played, as recorded
+// when the player left it.
+func LoadLastHandHistories() ([]engine.HandHistory, error) {
+	path, err := handsFilePath()
+	if err != nil {
+		return nil, err
+	}
+	return LoadHandHistories(path)
+}
+
+// LoadHandHistories reads hand histories from a JSON file holding either a
+// list of hands or a single hand.
+func LoadHandHistories(path string) ([]engine.HandHistory, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var hands []engine.HandHistory
+	if err := json.Unmarshal(data, &hands); err != nil {
+		var hand engine.HandHistory
+		if err := json.Unmarshal(data, &hand); err != nil {
+			return nil, fmt.Errorf("reading hand history: %w", err)
+		}
+		hands = []engine.HandHistory{hand}
+	}
+	if len(hands) == 0 {
+		return nil, errors.New("no hands recorded")
+	}
+	return hands, nil
+}
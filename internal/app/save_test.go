@@ -0,0 +1,176 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestMain points the save and hand-history files at a throwaway directory so
+// tests that quit a game never touch the real user config.
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "euchre-save-test")
+	if err != nil {
+		panic(err)
+	}
+	saveFilePath = func() (string, error) {
+		return filepath.Join(dir, "savegame.json"), nil
+	}
+	handsFilePath = func() (string, error) {
+		return filepath.Join(dir, "lastgame-hands.json"), nil
+	}
+	code := m.Run()
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+// driveToPlay applies first-legal, non-pass actions until the round reaches
+// the play phase with at least n cards on the current trick.
+func driveToPlay(t *testing.T, g *GamePlay, n int) {
+	t.Helper()
+	for g.game.Phase() != engine.PhasePlay || len(g.game.Round().CurrentTrick()) < n {
+		actions := g.game.LegalActions()
+		action := actions[0]
+		for _, a := range actions {
+			if a.Type() != engine.ActionPass {
+				action = a
+				break
+			}
+		}
+		if err := g.game.ApplyAction(action); err != nil {
+			t.Fatalf("apply failed: %v", err)
+		}
+	}
+}
+
+func TestQuitSavesAndResumeRestoresMidTrick(t *testing.T) {
+	t.Cleanup(deleteSavedGame)
+	g := NewGamePlayWithSettings(GameSettings{Variant: "Standard", StickTheDealer: true, Seed: 31})
+	g.isShuffling, g.isDealing = false, false
+	driveToPlay(t, g, 2)
+
+	g.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	if !hasSavedGame() {
+		t.Fatal("quitting a game in progress should save it")
+	}
+
+	save, err := loadSavedGame()
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if !save.Settings.StickTheDealer || save.Settings.Seed != 31 {
+		t.Errorf("saved settings lost the game's options: %+v", save.Settings)
+	}
+
+	resumed := NewGamePlayFromSave(save)
+	if resumed.isShuffling || resumed.isDealing {
+		t.Error("a resumed game should skip the shuffle and deal animations")
+	}
+	if resumed.game.Phase() != engine.PhasePlay {
+		t.Fatalf("resumed phase = %s, want Play", resumed.game.Phase())
+	}
+	if got, want := resumed.game.Round().CurrentTrick(), g.game.Round().CurrentTrick(); !reflect.DeepEqual(got, want) {
+		t.Errorf("partially played trick not restored: got %v want %v", got, want)
+	}
+	for p := 0; p < 4; p++ {
+		if !reflect.DeepEqual(resumed.game.Hand(p), g.game.Hand(p)) {
+			t.Errorf("seat %d hand not restored", p)
+		}
+	}
+	if resumed.game.Dealer() != g.game.Dealer() {
+		t.Errorf("dealer = %d, want %d", resumed.game.Dealer(), g.game.Dealer())
+	}
+}
+
+func TestResumeRestoresDefendAloneWindow(t *testing.T) {
+	t.Cleanup(deleteSavedGame)
+	g := NewGamePlayWithSettings(GameSettings{Variant: "Standard", DefendAlone: true, Seed: 5})
+	g.isShuffling, g.isDealing = false, false
+
+	bidder := g.game.CurrentPlayer()
+	if err := g.game.ApplyAction(engine.OrderUpAction{PlayerIdx: bidder, Alone: true}); err != nil {
+		t.Fatalf("order up failed: %v", err)
+	}
+	dealer := g.game.Dealer()
+	if err := g.game.ApplyAction(engine.DiscardAction{PlayerIdx: dealer, Card: g.game.Hand(dealer)[0]}); err != nil {
+		t.Fatalf("discard failed: %v", err)
+	}
+	if g.game.Phase() != engine.PhaseDefendAlone {
+		t.Fatalf("expected the defend-alone window, got %s", g.game.Phase())
+	}
+
+	g.saveOnExit()
+	save, err := loadSavedGame()
+	if err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	resumed := NewGamePlayFromSave(save)
+	if resumed.game.Phase() != engine.PhaseDefendAlone {
+		t.Fatalf("resumed phase = %s, want Defend Alone", resumed.game.Phase())
+	}
+	if resumed.game.CurrentPlayer() != g.game.CurrentPlayer() {
+		t.Errorf("resumed poll = %d, want %d", resumed.game.CurrentPlayer(), g.game.CurrentPlayer())
+	}
+}
+
+func TestTutorialIsNeverSaved(t *testing.T) {
+	t.Cleanup(deleteSavedGame)
+	deleteSavedGame()
+	g := NewGamePlayWithSettings(GameSettings{Variant: "Standard", Tutorial: true})
+	g.isShuffling, g.isDealing = false, false
+
+	g.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	if hasSavedGame() {
+		t.Error("quitting the tutorial must not create a save")
+	}
+}
+
+func TestMainMenuOffersResumeOnlyWithSave(t *testing.T) {
+	t.Cleanup(deleteSavedGame)
+	deleteSavedGame()
+	if got := NewMainMenu().menu.Items[0].Label; got == menuResumeGame {
+		t.Fatal("Resume Game should not be offered without a saved game")
+	}
+
+	g := NewGamePlayWithSettings(GameSettings{Variant: "Standard"})
+	g.saveOnExit()
+
+	m := NewMainMenu()
+	if got := m.menu.Items[0].Label; got != menuResumeGame {
+		t.Fatalf("first item = %q, want %q", got, menuResumeGame)
+	}
+	_, cmd := m.handleSelect()
+	if cmd == nil {
+		t.Fatal("selecting Resume Game produced no command")
+	}
+	nav, ok := cmd().(NavigateMsg)
+	if !ok || nav.Screen != ScreenGamePlay {
+		t.Fatalf("Resume Game should navigate to game play, got %#v", nav)
+	}
+	if _, ok := nav.Data.(*SavedGame); !ok {
+		t.Errorf("Resume Game should carry the saved game, got %T", nav.Data)
+	}
+
+	// The remaining items still route by label, not position.
+	m.menu.Selected = 1
+	_, cmd = m.handleSelect()
+	if nav, ok := cmd().(NavigateMsg); !ok || nav.Screen != ScreenGameSetup {
+		t.Errorf("Play Game should still open setup with Resume present")
+	}
+}
+
+func TestCtrlCSavesGameInProgress(t *testing.T) {
+	t.Cleanup(deleteSavedGame)
+	deleteSavedGame()
+	a := New()
+	a.navigate(ScreenGamePlay, GameSettings{Variant: "Standard"})
+
+	a.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	if !hasSavedGame() {
+		t.Error("ctrl+c during a game should save it")
+	}
+}
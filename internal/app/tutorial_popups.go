@@ -92,7 +92,7 @@ func (g *GamePlay) teachableConcept() *concept {
 			if !g.shownConcepts["euchre"] && last.WasEuchred {
 				return euchreConcept
 			}
-			if !g.shownConcepts["march"] && last.MakerTricks == 5 {
+			if !g.shownConcepts["march"] && last.MakerTricks == g.game.Rules().TrickCount() {
 				return marchConcept
 			}
 		}
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestUndoKeyTakesBackHumansLastCard(t *testing.T) {
+	g := NewGamePlayWithSettings(GameSettings{Variant: "Standard", StickTheDealer: true, Seed: 8})
+	g.isShuffling, g.isDealing = false, false
+	driveToPlay(t, g, 0)
+
+	// Play round the table until the human has played and it is their turn again.
+	var before engine.RoundSnapshot
+	var played bool
+	for !played || g.game.CurrentPlayer() != g.humanPlayer {
+		if g.game.CurrentPlayer() == g.humanPlayer {
+			before = g.game.Round().Snapshot()
+			played = true
+		}
+		if err := g.game.ApplyAction(g.game.LegalActions()[0]); err != nil {
+			t.Fatalf("play failed: %v", err)
+		}
+	}
+
+	g.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	if !reflect.DeepEqual(before, g.game.Round().Snapshot()) {
+		t.Fatal("undo should restore the round to the human's previous decision")
+	}
+	if g.game.Undos() != 1 {
+		t.Errorf("the takeback should be counted on the game, got %d", g.game.Undos())
+	}
+}
+
+func TestUndoIsRefusedOffTurn(t *testing.T) {
+	g := NewGamePlayWithSettings(GameSettings{Variant: "Standard", Seed: 8})
+	g.isShuffling, g.isDealing = false, false
+	for g.game.CurrentPlayer() == g.humanPlayer {
+		if err := g.game.ApplyAction(g.game.LegalActions()[0]); err != nil {
+			t.Fatalf("apply failed: %v", err)
+		}
+	}
+	log := len(g.game.Round().ActionLog())
+
+	g.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	if got := len(g.game.Round().ActionLog()); got != log || g.game.Undos() != 0 {
+		t.Error("undo must not rewind while an AI is to act")
+	}
+}
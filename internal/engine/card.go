@@ -52,6 +52,22 @@ func (s Suit) String() string {
 	}
 }
 
+// MarshalText encodes the suit by name so snapshots stay readable.
+func (s Suit) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText decodes a suit name written by MarshalText.
+func (s *Suit) UnmarshalText(text []byte) error {
+	for _, candidate := range []Suit{Clubs, Diamonds, Hearts, Spades, NoSuit} {
+		if candidate.String() == string(text) {
+			*s = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown suit %q", text)
+}
+
 // Symbol returns the Unicode symbol for the suit
 func (s Suit) Symbol() string {
 	switch s {
@@ -79,11 +95,24 @@ const (
 	King
 	Ace
 	Joker // For British Euchre with Benny
+
+	// The low ranks of the larger packs come after Joker so the zero Card
+	// stays the Nine of Clubs. Compare ranks by TrumpValue or OffSuitValue,
+	// never by their numbers.
+	Six
+	Seven
+	Eight
 )
 
 // String returns the rank name
 func (r Rank) String() string {
 	switch r {
+	case Six:
+		return "6"
+	case Seven:
+		return "7"
+	case Eight:
+		return "8"
 	case Nine:
 		return "9"
 	case Ten:
@@ -103,10 +132,26 @@ func (r Rank) String() string {
 	}
 }
 
+// MarshalText encodes the rank by its short name ("9", "J", "A", ...).
+func (r Rank) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText decodes a rank name written by MarshalText.
+func (r *Rank) UnmarshalText(text []byte) error {
+	for _, candidate := range []Rank{Six, Seven, Eight, Nine, Ten, Jack, Queen, King, Ace, Joker} {
+		if candidate.String() == string(text) {
+			*r = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown rank %q", text)
+}
+
 // Card represents a playing card
 type Card struct {
-	Suit Suit
-	Rank Rank
+	Suit Suit `json:"suit"`
+	Rank Rank `json:"rank"`
 }
 
 // NewCard creates a new card
@@ -151,9 +196,9 @@ func (c Card) IsBower(trump Suit) bool {
 }
 
 // EffectiveSuit returns the suit of the card, accounting for the Left Bower
-// which is considered part of the trump suit
+// and the Benny (joker), which are considered part of the trump suit
 func (c Card) EffectiveSuit(trump Suit) Suit {
-	if c.IsLeftBower(trump) {
+	if c.IsLeftBower(trump) || c.IsJoker() {
 		return trump
 	}
 	return c.Suit
@@ -190,7 +235,7 @@ func (c Card) TrumpValue(trump Suit) int {
 		return 80
 	}
 
-	// Regular trump cards: A, K, Q, 10, 9
+	// Regular trump cards: A, K, Q, 10, 9, then 8, 7, 6 in the larger packs
 	switch c.Rank {
 	case Ace:
 		return 70
@@ -202,6 +247,12 @@ func (c Card) TrumpValue(trump Suit) int {
 		return 40
 	case Nine:
 		return 30
+	case Eight:
+		return 25
+	case Seven:
+		return 20
+	case Six:
+		return 15
 	default:
 		return 0
 	}
@@ -222,6 +273,12 @@ func (c Card) OffSuitValue() int {
 		return 20
 	case Nine:
 		return 10
+	case Eight:
+		return 8
+	case Seven:
+		return 6
+	case Six:
+		return 4
 	default:
 		return 0
 	}
@@ -229,6 +286,6 @@ func (c Card) OffSuitValue() int {
 
 // PlayedCard represents a card played by a specific player
 type PlayedCard struct {
-	Player int
-	Card   Card
+	Player int  `json:"player"`
+	Card   Card `json:"card"`
 }
@@ -0,0 +1,92 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHandCloneIsIndependent(t *testing.T) {
+	h := NewHandWith([]Card{NewCard(Hearts, Ace), NewCard(Spades, Nine)})
+	c := h.Clone()
+	c.Remove(NewCard(Hearts, Ace))
+	c.Add(NewCard(Clubs, King))
+
+	if h.Size() != 2 || !h.Contains(NewCard(Hearts, Ace)) || h.Contains(NewCard(Clubs, King)) {
+		t.Errorf("changing the clone changed the original: %v", h.Cards())
+	}
+}
+
+func TestTrickCloneIsIndependent(t *testing.T) {
+	tr := NewTrick(Hearts)
+	tr.Play(0, NewCard(Spades, Ace))
+	c := tr.Clone()
+	c.Play(1, NewCard(Hearts, Nine))
+
+	if tr.Size() != 1 {
+		t.Errorf("original trick has %d cards after playing to the clone, want 1", tr.Size())
+	}
+	if c.LeadSuit() != Spades || c.Winner() != 1 {
+		t.Errorf("clone lost the trick state: lead %s winner %d", c.LeadSuit(), c.Winner())
+	}
+}
+
+func TestRoundCloneIsIndependent(t *testing.T) {
+	round := orderedUpRound(t)
+	if err := round.ApplyAction(round.LegalActions()[0]); err != nil {
+		t.Fatalf("lead failed: %v", err)
+	}
+	before := round.Snapshot()
+
+	c := round.Clone()
+	if !reflect.DeepEqual(before, c.Snapshot()) {
+		t.Fatal("clone should start identical to the original")
+	}
+	for !c.IsComplete() {
+		if err := c.ApplyAction(c.LegalActions()[0]); err != nil {
+			t.Fatalf("play on clone failed: %v", err)
+		}
+	}
+
+	if !reflect.DeepEqual(before, round.Snapshot()) {
+		t.Error("playing out the clone changed the original round")
+	}
+}
+
+func TestGameCloneIsIndependent(t *testing.T) {
+	game := midTrickGame(t)
+	want, _ := game.Snapshot()
+	var events int
+	game.Subscribe(func(Event) { events++ })
+
+	c := game.Clone()
+	playFirstLegal(t, c)
+	if !c.IsOver() {
+		c.StartRound()
+	}
+
+	got, _ := game.Snapshot()
+	if !reflect.DeepEqual(want, got) {
+		t.Error("playing on the clone changed the original game")
+	}
+	if events != 0 {
+		t.Errorf("the clone notified the original's subscribers %d times", events)
+	}
+}
+
+func TestSeededGameCloneDealsTheSameFuture(t *testing.T) {
+	game := midTrickGame(t)
+	c := game.Clone()
+
+	playFirstLegal(t, game)
+	playFirstLegal(t, c)
+	if game.IsOver() {
+		return
+	}
+	game.StartRound()
+	c.StartRound()
+	for p := 0; p < game.NumPlayers(); p++ {
+		if !reflect.DeepEqual(game.Hand(p), c.Hand(p)) {
+			t.Fatalf("seat %d next deal diverged: %v vs %v", p, game.Hand(p), c.Hand(p))
+		}
+	}
+}
@@ -33,18 +33,23 @@ func NewStandardDeck() *Deck {
 // NewDeck32 creates a 32-card Euchre deck (7, 8, 9, 10, J, Q, K, A of each suit)
 // Used in some regional variants like New Zealand
 func NewDeck32() *Deck {
-	cards := make([]Card, 0, 32)
-	suits := []Suit{Clubs, Diamonds, Hearts, Spades}
-	// Note: We'd need to add Seven and Eight ranks for this
-	// For now, using Nine as minimum
-	ranks := []Rank{Nine, Ten, Jack, Queen, King, Ace}
+	return newDeck([]Rank{Seven, Eight, Nine, Ten, Jack, Queen, King, Ace})
+}
 
-	for _, suit := range suits {
+// NewDeck36 creates the 36-card deck six-handed Euchre is dealt from
+// (6 through A of each suit)
+func NewDeck36() *Deck {
+	return newDeck([]Rank{Six, Seven, Eight, Nine, Ten, Jack, Queen, King, Ace})
+}
+
+// newDeck creates a deck holding each of the ranks in every suit
+func newDeck(ranks []Rank) *Deck {
+	cards := make([]Card, 0, 4*len(ranks))
+	for _, suit := range []Suit{Clubs, Diamonds, Hearts, Spades} {
 		for _, rank := range ranks {
 			cards = append(cards, NewCard(suit, rank))
 		}
 	}
-
 	return &Deck{cards: cards}
 }
 
@@ -132,6 +137,20 @@ func (c BritishDeckConfig) CreateDeck() *Deck {
 	return NewBritishDeck()
 }
 
+// Deck32Config creates 32-card decks with the 7s and 8s
+type Deck32Config struct{}
+
+func (c Deck32Config) CreateDeck() *Deck {
+	return NewDeck32()
+}
+
+// Deck36Config creates 36-card decks for six-handed Euchre
+type Deck36Config struct{}
+
+func (c Deck36Config) CreateDeck() *Deck {
+	return NewDeck36()
+}
+
 // Hand represents a player's hand of cards
 type Hand struct {
 	cards []Card
@@ -149,6 +168,11 @@ func NewHandWith(cards []Card) *Hand {
 	return h
 }
 
+// Clone returns an independent copy of the hand
+func (h *Hand) Clone() *Hand {
+	return NewHandWith(h.cards)
+}
+
 // Cards returns a copy of the cards in the hand
 func (h *Hand) Cards() []Card {
 	result := make([]Card, len(h.cards))
@@ -282,7 +306,7 @@ func (h *Hand) compareCards(a, b Card, trump Suit) int {
 	if a.Suit != b.Suit {
 		return int(a.Suit) - int(b.Suit)
 	}
-	return int(b.Rank) - int(a.Rank)
+	return b.OffSuitValue() - a.OffSuitValue()
 }
 
 // Clear removes all cards from the hand
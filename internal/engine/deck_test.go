@@ -29,6 +29,58 @@ func TestNewStandardDeck(t *testing.T) {
 	}
 }
 
+func TestNewDeck32(t *testing.T) {
+	deck := NewDeck32()
+
+	if deck.Size() != 32 {
+		t.Errorf("32-card deck should have 32 cards, got %d", deck.Size())
+	}
+	cardSet := make(map[Card]bool)
+	for _, c := range deck.Cards() {
+		cardSet[c] = true
+	}
+	if len(cardSet) != 32 {
+		t.Errorf("32-card deck has %d distinct cards", len(cardSet))
+	}
+	for _, suit := range []Suit{Clubs, Diamonds, Hearts, Spades} {
+		for _, rank := range []Rank{Seven, Eight, Nine, Ace} {
+			if !cardSet[NewCard(suit, rank)] {
+				t.Errorf("Missing card: %s", NewCard(suit, rank))
+			}
+		}
+		if cardSet[NewCard(suit, Six)] {
+			t.Errorf("32-card deck holds the %s", NewCard(suit, Six))
+		}
+	}
+}
+
+func TestNewDeck36(t *testing.T) {
+	deck := NewDeck36()
+
+	if deck.Size() != 36 {
+		t.Errorf("36-card deck should have 36 cards, got %d", deck.Size())
+	}
+	cardSet := make(map[Card]bool)
+	for _, c := range deck.Cards() {
+		cardSet[c] = true
+	}
+	if len(cardSet) != 36 {
+		t.Errorf("36-card deck has %d distinct cards", len(cardSet))
+	}
+
+	// The low ranks rank below the nine, in and out of trump
+	low := []Rank{Nine, Eight, Seven, Six}
+	for i := 1; i < len(low); i++ {
+		hi, lo := NewCard(Hearts, low[i-1]), NewCard(Hearts, low[i])
+		if !cardSet[lo] {
+			t.Errorf("Missing card: %s", lo)
+		}
+		if hi.TrumpValue(Hearts) <= lo.TrumpValue(Hearts) || hi.OffSuitValue() <= lo.OffSuitValue() {
+			t.Errorf("%s should outrank %s", hi, lo)
+		}
+	}
+}
+
 func TestNewBritishDeck(t *testing.T) {
 	deck := NewBritishDeck()
 
@@ -0,0 +1,36 @@
+package engine
+
+import "math/rand"
+
+// Board is one deal of duplicate Euchre: who deals and the seed the deck is
+// shuffled from. Every table that plays a board gets exactly the same cards,
+// so results can be compared deal by deal without the luck of the deal.
+type Board struct {
+	Number int   `json:"number"` // 1-based position in the match
+	Dealer int   `json:"dealer"`
+	Seed   int64 `json:"seed"`
+}
+
+// DuplicateBoards derives n boards from a seed, rotating the dealer. They
+// are the deals a game seeded the same way makes, for as long as it has no
+// misdeal.
+func DuplicateBoards(seed int64, n, numPlayers int) []Board {
+	rng := rand.New(rand.NewSource(seed))
+	boards := make([]Board, n)
+	for i := range boards {
+		boards[i] = Board{Number: i + 1, Dealer: i % numPlayers, Seed: rng.Int63()}
+	}
+	return boards
+}
+
+// Points returns the points team scored on the round
+func (r RoundResult) Points(team int) int {
+	switch {
+	case r.Makers < 0:
+		return 0
+	case team == r.Makers:
+		return r.MakerPoints
+	default:
+		return r.DefendPoints
+	}
+}
@@ -0,0 +1,59 @@
+package engine
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDuplicateBoardsAreTheDealsOfASeededGame(t *testing.T) {
+	config := DefaultGameConfig()
+	config.Seed = 2024
+	game := NewGame(config)
+	boards := DuplicateBoards(config.Seed, 3, config.NumPlayers)
+
+	for _, want := range boards {
+		game.StartRound()
+		if got := game.Board(); got != want {
+			t.Fatalf("deal %d: Board() = %+v, want %+v", want.Number, got, want)
+		}
+
+		// Another table dealt the same board gets the same cards.
+		other := NewGame(DefaultGameConfig())
+		other.StartBoard(want)
+		if other.Dealer() != want.Dealer {
+			t.Errorf("board %d: dealer %d, want %d", want.Number, other.Dealer(), want.Dealer)
+		}
+		for p := 0; p < config.NumPlayers; p++ {
+			if !reflect.DeepEqual(other.Hand(p), game.Hand(p)) {
+				t.Fatalf("board %d seat %d: got %v, want %v", want.Number, p, other.Hand(p), game.Hand(p))
+			}
+		}
+		if other.TurnedCard() != game.TurnedCard() {
+			t.Errorf("board %d: turned %v, want %v", want.Number, other.TurnedCard(), game.TurnedCard())
+		}
+
+		playFirstLegal(t, game)
+		if game.IsMisdeal() {
+			t.Fatal("first-legal play should never throw in a hand")
+		}
+	}
+}
+
+func TestRoundResultPoints(t *testing.T) {
+	tests := []struct {
+		name   string
+		result RoundResult
+		want   [2]int
+	}{
+		{"made", RoundResult{Makers: 0, MakerTricks: 3, MakerPoints: 1}, [2]int{1, 0}},
+		{"march", RoundResult{Makers: 1, MakerTricks: 5, MakerPoints: 2}, [2]int{0, 2}},
+		{"euchre", RoundResult{Makers: 1, MakerTricks: 2, WasEuchred: true, DefendPoints: 2}, [2]int{2, 0}},
+		{"misdeal", RoundResult{Makers: -1}, [2]int{0, 0}},
+	}
+	for _, tt := range tests {
+		got := [2]int{tt.result.Points(0), tt.result.Points(1)}
+		if got != tt.want {
+			t.Errorf("%s: points = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// Package enginetest provides rounds in known states for tests of the
+// packages built on the engine.
+package enginetest
+
+import (
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// PlayedIntoSecondTrick returns a seeded round in which trump has been
+// ordered up and the first trick and one card of the second are played.
+func PlayedIntoSecondTrick(t testing.TB) *engine.Round {
+	t.Helper()
+	round := engine.NewRound(4, 0)
+	deck := engine.NewStandardDeck()
+	deck.Seed(42)
+	round.Deal(deck)
+	if err := round.ApplyAction(engine.OrderUpAction{PlayerIdx: 1}); err != nil {
+		t.Fatalf("order up failed: %v", err)
+	}
+	if err := round.ApplyAction(engine.DiscardAction{PlayerIdx: 0, Card: round.Hand(0)[0]}); err != nil {
+		t.Fatalf("discard failed: %v", err)
+	}
+	for len(round.TrickHistory()) < 1 || len(round.CurrentTrick()) < 1 {
+		// Prefer off-suit plays so voids show up.
+		legal := round.LegalActions()
+		if err := round.ApplyAction(legal[len(legal)-1]); err != nil {
+			t.Fatalf("play failed: %v", err)
+		}
+	}
+	return round
+}
@@ -0,0 +1,246 @@
+package engine
+
+// EventType identifies the kind of a game Event
+type EventType int
+
+const (
+	EventRoundStarted EventType = iota
+	EventDealt
+	EventBidMade
+	EventTrumpSet
+	EventDealerDiscarded
+	EventDefendAloneDeclared
+	EventCardPlayed
+	EventTrickWon
+	EventRoundScored
+	EventMisdeal
+	EventGameOver
+	EventRewound
+)
+
+func (t EventType) String() string {
+	switch t {
+	case EventRoundStarted:
+		return "Round Started"
+	case EventDealt:
+		return "Dealt"
+	case EventBidMade:
+		return "Bid Made"
+	case EventTrumpSet:
+		return "Trump Set"
+	case EventDealerDiscarded:
+		return "Dealer Discarded"
+	case EventDefendAloneDeclared:
+		return "Defend Alone Declared"
+	case EventCardPlayed:
+		return "Card Played"
+	case EventTrickWon:
+		return "Trick Won"
+	case EventRoundScored:
+		return "Round Scored"
+	case EventMisdeal:
+		return "Misdeal"
+	case EventGameOver:
+		return "Game Over"
+	case EventRewound:
+		return "Rewound"
+	default:
+		return "Unknown"
+	}
+}
+
+// Event is something that happened in a Game, delivered to subscribers after
+// the state change it describes has been applied.
+type Event interface {
+	Type() EventType
+}
+
+// RoundStartedEvent is emitted when a new round begins, before the deal
+type RoundStartedEvent struct {
+	Deal   int // 1-based count of deals this game, misdeals included
+	Dealer int
+}
+
+func (e RoundStartedEvent) Type() EventType { return EventRoundStarted }
+
+// DealtEvent is emitted once the cards are dealt and a card is turned up
+type DealtEvent struct {
+	Dealer     int
+	TurnedCard Card
+}
+
+func (e DealtEvent) Type() EventType { return EventDealt }
+
+// BidMadeEvent is emitted for every bidding decision: a pass, an order-up or
+// a trump call. Suit is the suit named (the turned card's for an order-up)
+// and NoSuit for a pass. Alone is whether the maker actually plays alone,
+// which the rules may refuse even when it was asked for.
+type BidMadeEvent struct {
+	Player   int
+	Action   ActionType
+	BidRound int
+	Suit     Suit
+	Alone    bool
+}
+
+func (e BidMadeEvent) Type() EventType { return EventBidMade }
+
+// TrumpSetEvent is emitted when a bid fixes trump. Alone is whether the
+// maker actually plays alone.
+type TrumpSetEvent struct {
+	Trump Suit
+	Maker int
+	Alone bool
+}
+
+func (e TrumpSetEvent) Type() EventType { return EventTrumpSet }
+
+// DealerDiscardedEvent is emitted when the dealer discards after picking up.
+// A lone maker discarding the cards their partners passed (Rules.LonerExchange)
+// is reported the same way, with Dealer set to the maker.
+type DealerDiscardedEvent struct {
+	Dealer int
+	Card   Card
+}
+
+func (e DealerDiscardedEvent) Type() EventType { return EventDealerDiscarded }
+
+// DefendAloneDeclaredEvent is emitted when a defender chooses to defend alone
+type DefendAloneDeclaredEvent struct {
+	Player int
+}
+
+func (e DefendAloneDeclaredEvent) Type() EventType { return EventDefendAloneDeclared }
+
+// CardPlayedEvent is emitted for every card played to a trick
+type CardPlayedEvent struct {
+	Player int
+	Card   Card
+}
+
+func (e CardPlayedEvent) Type() EventType { return EventCardPlayed }
+
+// TrickWonEvent is emitted when the last card of a trick is played
+type TrickWonEvent struct {
+	Trick  int // 1-based trick number within the round
+	Result TrickResult
+}
+
+func (e TrickWonEvent) Type() EventType { return EventTrickWon }
+
+// RoundScoredEvent is emitted when a round ends and its points are added
+type RoundScoredEvent struct {
+	Result RoundResult
+	Scores []int // team scores after this round
+}
+
+func (e RoundScoredEvent) Type() EventType { return EventRoundScored }
+
+// MisdealEvent is emitted when everyone passes twice and the hand is thrown in
+type MisdealEvent struct {
+	Dealer int // deals again
+}
+
+func (e MisdealEvent) Type() EventType { return EventMisdeal }
+
+// GameOverEvent is emitted when a team reaches the target score
+type GameOverEvent struct {
+	Winner int
+	Scores []int
+}
+
+func (e GameOverEvent) Type() EventType { return EventGameOver }
+
+// RewoundEvent is emitted when a decision is taken back (Game.Undo). The
+// round is back to its state after its first Actions recorded actions, and
+// every event since then no longer holds; subscribers should rebuild their
+// view of the round from Game.Round().
+type RewoundEvent struct {
+	Player  int // who took their decision back
+	Actions int // recorded actions of the round that still stand
+}
+
+func (e RewoundEvent) Type() EventType { return EventRewound }
+
+// subscriber is a registered event listener
+type subscriber struct {
+	id int
+	fn func(Event)
+}
+
+// Subscribe registers fn to receive every event the game emits from now on,
+// in order and synchronously, after the state change it describes. The
+// returned function removes the subscription.
+func (g *Game) Subscribe(fn func(Event)) (unsubscribe func()) {
+	g.nextSubscriberID++
+	id := g.nextSubscriberID
+	g.subscribers = append(g.subscribers, subscriber{id: id, fn: fn})
+	return func() {
+		for i, s := range g.subscribers {
+			if s.id == id {
+				g.subscribers = append(g.subscribers[:i:i], g.subscribers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// emit delivers an event to every subscriber
+func (g *Game) emit(e Event) {
+	for _, s := range g.subscribers {
+		s.fn(e)
+	}
+}
+
+// emitActionEvents emits the events caused by an action that has just been
+// applied. phase and tricks are the round's phase and completed trick count
+// from before the action.
+func (g *Game) emitActionEvents(action Action, phase GamePhase, tricks int) {
+	if len(g.subscribers) == 0 {
+		return
+	}
+	r := g.currentRound
+
+	switch a := action.(type) {
+	case PassAction:
+		// A pass in the defend-alone window declines; it is not a bid.
+		if phase == PhaseBidRound1 || phase == PhaseBidRound2 {
+			g.emit(BidMadeEvent{Player: a.PlayerIdx, Action: ActionPass, BidRound: bidRoundOf(phase), Suit: NoSuit})
+		}
+	case OrderUpAction:
+		g.emit(BidMadeEvent{Player: a.PlayerIdx, Action: ActionOrderUp, BidRound: 1, Suit: r.Trump(), Alone: r.IsAlone()})
+		g.emit(TrumpSetEvent{Trump: r.Trump(), Maker: a.PlayerIdx, Alone: r.IsAlone()})
+	case CallTrumpAction:
+		g.emit(BidMadeEvent{Player: a.PlayerIdx, Action: ActionCallTrump, BidRound: 2, Suit: a.Suit, Alone: r.IsAlone()})
+		g.emit(TrumpSetEvent{Trump: a.Suit, Maker: a.PlayerIdx, Alone: r.IsAlone()})
+	case DiscardAction:
+		g.emit(DealerDiscardedEvent{Dealer: a.PlayerIdx, Card: a.Card})
+	case DefendAloneAction:
+		g.emit(DefendAloneDeclaredEvent{Player: a.PlayerIdx})
+	case PlayCardAction:
+		g.emit(CardPlayedEvent{Player: a.PlayerIdx, Card: a.Card})
+		if history := r.TrickHistory(); len(history) > tricks {
+			g.emit(TrickWonEvent{Trick: len(history), Result: history[len(history)-1]})
+		}
+	}
+
+	if !r.IsComplete() {
+		return
+	}
+	if r.IsMisdeal() {
+		g.emit(MisdealEvent{Dealer: g.dealer})
+		return
+	}
+	g.emit(RoundScoredEvent{Result: g.roundHistory[len(g.roundHistory)-1], Scores: g.Scores()})
+	if g.IsOver() {
+		g.emit(GameOverEvent{Winner: g.Winner(), Scores: g.Scores()})
+	}
+}
+
+// bidRoundOf returns the bidding round a bidding phase belongs to
+func bidRoundOf(phase GamePhase) int {
+	if phase == PhaseBidRound2 {
+		return 2
+	}
+	return 1
+}
@@ -0,0 +1,201 @@
+package engine
+
+import "testing"
+
+// recordEvents subscribes to the game and returns a pointer to the events it
+// emits.
+func recordEvents(game *Game) *[]Event {
+	var events []Event
+	game.Subscribe(func(e Event) { events = append(events, e) })
+	return &events
+}
+
+// countEvents tallies events by type.
+func countEvents(events []Event) map[EventType]int {
+	counts := make(map[EventType]int)
+	for _, e := range events {
+		counts[e.Type()]++
+	}
+	return counts
+}
+
+func TestEventsCoverAWholeGame(t *testing.T) {
+	config := DefaultGameConfig()
+	config.Seed = 99
+	game := NewGame(config)
+	events := recordEvents(game)
+
+	for !game.IsOver() {
+		game.StartRound()
+		playFirstLegal(t, game)
+	}
+
+	got := *events
+	if got[0].Type() != EventRoundStarted || got[1].Type() != EventDealt {
+		t.Fatalf("game should open with Round Started then Dealt, got %s, %s", got[0].Type(), got[1].Type())
+	}
+	last, ok := got[len(got)-1].(GameOverEvent)
+	if !ok {
+		t.Fatalf("last event = %s, want Game Over", got[len(got)-1].Type())
+	}
+	if last.Winner != game.Winner() {
+		t.Errorf("Game Over winner = %d, want %d", last.Winner, game.Winner())
+	}
+
+	counts := countEvents(got)
+	rounds := len(game.RoundHistory())
+	if counts[EventRoundScored] != rounds {
+		t.Errorf("%d Round Scored events for %d rounds", counts[EventRoundScored], rounds)
+	}
+	if counts[EventTrickWon] != 5*rounds {
+		t.Errorf("%d Trick Won events for %d rounds, want 5 each", counts[EventTrickWon], rounds)
+	}
+	if counts[EventTrumpSet] != rounds {
+		t.Errorf("%d Trump Set events for %d rounds", counts[EventTrumpSet], rounds)
+	}
+	if counts[EventGameOver] != 1 {
+		t.Errorf("got %d Game Over events, want 1", counts[EventGameOver])
+	}
+}
+
+func TestEventsFollowEachDecision(t *testing.T) {
+	game := NewGame(GameConfig{Seed: 5})
+	events := recordEvents(game)
+	game.StartRound()
+	dealer := game.Dealer()
+	first := game.CurrentPlayer()
+
+	*events = nil
+	if err := game.ApplyAction(OrderUpAction{PlayerIdx: first}); err != nil {
+		t.Fatalf("order up failed: %v", err)
+	}
+	bid, ok := (*events)[0].(BidMadeEvent)
+	if !ok || bid.Player != first || bid.Action != ActionOrderUp || bid.BidRound != 1 {
+		t.Fatalf("first event = %#v, want an order-up bid by seat %d", (*events)[0], first)
+	}
+	if set, ok := (*events)[1].(TrumpSetEvent); !ok || set.Trump != game.Trump() || set.Maker != first {
+		t.Errorf("second event = %#v, want Trump Set to %s by seat %d", (*events)[1], game.Trump(), first)
+	}
+
+	*events = nil
+	discard := game.Hand(dealer)[0]
+	if err := game.ApplyAction(DiscardAction{PlayerIdx: dealer, Card: discard}); err != nil {
+		t.Fatalf("discard failed: %v", err)
+	}
+	if e, ok := (*events)[0].(DealerDiscardedEvent); !ok || e.Card != discard {
+		t.Errorf("discard event = %#v, want %s discarded", (*events)[0], discard)
+	}
+
+	*events = nil
+	leader := game.CurrentPlayer()
+	card := game.LegalActions()[0].(PlayCardAction).Card
+	if err := game.ApplyAction(PlayCardAction{PlayerIdx: leader, Card: card}); err != nil {
+		t.Fatalf("play failed: %v", err)
+	}
+	if e, ok := (*events)[0].(CardPlayedEvent); !ok || e.Player != leader || e.Card != card {
+		t.Errorf("play event = %#v, want %s by seat %d", (*events)[0], card, leader)
+	}
+}
+
+func TestAloneEventsReportTheContractPlayed(t *testing.T) {
+	// With three players for themselves nobody has a partner to sit out, so
+	// an order-up alone is played as an ordinary contract.
+	game := NewGame(GameConfig{NumPlayers: 3, Rules: Rules{Teams: 3}, Seed: 5})
+	events := recordEvents(game)
+	game.StartRound()
+	first := game.CurrentPlayer()
+
+	*events = nil
+	if err := game.ApplyAction(OrderUpAction{PlayerIdx: first, Alone: true}); err != nil {
+		t.Fatalf("order up failed: %v", err)
+	}
+	if game.Round().IsAlone() {
+		t.Fatal("a player with no partner can't go alone")
+	}
+	if bid := (*events)[0].(BidMadeEvent); bid.Alone {
+		t.Errorf("bid event = %#v, want the contract as played, not alone", bid)
+	}
+	if set := (*events)[1].(TrumpSetEvent); set.Alone {
+		t.Errorf("trump event = %#v, want the contract as played, not alone", set)
+	}
+}
+
+func TestUndoEmitsRewound(t *testing.T) {
+	game := NewGame(GameConfig{Seed: 5})
+	game.StartRound()
+	if err := game.ApplyAction(OrderUpAction{PlayerIdx: game.CurrentPlayer()}); err != nil {
+		t.Fatalf("order up failed: %v", err)
+	}
+	if err := game.ApplyAction(DiscardAction{PlayerIdx: game.Dealer(), Card: game.Hand(game.Dealer())[0]}); err != nil {
+		t.Fatalf("discard failed: %v", err)
+	}
+
+	// A subscriber keeps the cards played this round from the events alone,
+	// rebuilding from the round when a move is taken back.
+	var plays []PlayedCard
+	game.Subscribe(func(e Event) {
+		switch e := e.(type) {
+		case CardPlayedEvent:
+			plays = append(plays, PlayedCard{Player: e.Player, Card: e.Card})
+		case RewoundEvent:
+			plays = nil
+			for _, rec := range game.Round().ActionLog()[:e.Actions] {
+				if rec.Type == ActionPlayCard {
+					plays = append(plays, PlayedCard{Player: rec.Player, Card: *rec.Card})
+				}
+			}
+		}
+	})
+
+	leader := game.CurrentPlayer()
+	for i := 0; i < 3; i++ {
+		if err := game.ApplyAction(game.LegalActions()[0]); err != nil {
+			t.Fatalf("play failed: %v", err)
+		}
+	}
+	if err := game.Undo(NextPlayer(leader, 4)); err != nil {
+		t.Fatalf("undo failed: %v", err)
+	}
+	if len(plays) != 1 || plays[0].Player != leader {
+		t.Fatalf("after the takeback the subscriber has plays %v, want only the lead", plays)
+	}
+	if err := game.ApplyAction(game.LegalActions()[len(game.LegalActions())-1]); err != nil {
+		t.Fatalf("replay failed: %v", err)
+	}
+	if got := game.Round().CurrentTrick(); len(plays) != len(got) || plays[1] != got[1] {
+		t.Errorf("subscriber has plays %v, round has %v", plays, got)
+	}
+}
+
+func TestMisdealEvent(t *testing.T) {
+	game := NewGame(GameConfig{Rules: Rules{AllowMisdeal: true}, Seed: 4})
+	events := recordEvents(game)
+	game.StartRound()
+	for i := 0; i < 8; i++ {
+		if err := game.ApplyAction(PassAction{PlayerIdx: game.CurrentPlayer()}); err != nil {
+			t.Fatalf("pass failed: %v", err)
+		}
+	}
+
+	counts := countEvents(*events)
+	if counts[EventBidMade] != 8 || counts[EventMisdeal] != 1 || counts[EventRoundScored] != 0 {
+		t.Errorf("eight passes should give 8 bids and a misdeal with no score, got %v", counts)
+	}
+}
+
+func TestUnsubscribeStopsEvents(t *testing.T) {
+	game := NewGame(GameConfig{Seed: 4})
+	var n int
+	unsubscribe := game.Subscribe(func(Event) { n++ })
+	other := recordEvents(game)
+	game.StartRound()
+	unsubscribe()
+	_ = game.ApplyAction(PassAction{PlayerIdx: game.CurrentPlayer()})
+
+	if n != 2 {
+		t.Errorf("unsubscribed listener saw %d events, want the 2 from the deal", n)
+	}
+	if len(*other) != 3 {
+		t.Errorf("remaining listener saw %d events, want 3", len(*other))
+	}
+}
@@ -1,5 +1,7 @@
 package engine
 
+import "math/rand"
+
 // Game represents a complete Euchre game
 type Game struct {
 	// Configuration
@@ -13,9 +15,19 @@ type Game struct {
 	dealer       int
 	currentRound *Round
 	deck         *Deck
+	seed         int64
+	rng          *rand.Rand // nil when unseeded: decks shuffle from the global source
+	deals        int        // rounds dealt so far (how far rng has advanced)
+	board        Board      // the current round's deal
+	undos        int        // takebacks made with Undo
 
 	// History
 	roundHistory []RoundResult
+	handHistory  []HandHistory // full record of every finished round, misdeals included
+
+	// Event subscribers (see Subscribe)
+	subscribers      []subscriber
+	nextSubscriberID int
 }
 
 // GameConfig contains configuration for a new game
@@ -24,6 +36,10 @@ type GameConfig struct {
 	TargetScore int
 	DeckConfig  DeckConfig
 	Rules       Rules
+
+	// Seed makes every deal in the game reproducible: each round's deck is
+	// shuffled from an RNG derived from it. Zero leaves the game unseeded.
+	Seed int64
 }
 
 // DefaultGameConfig returns the standard 4-player Euchre configuration
@@ -48,25 +64,105 @@ func NewGame(config GameConfig) *Game {
 		config.DeckConfig = StandardDeckConfig{}
 	}
 
-	numTeams := 2 // Standard Euchre has 2 teams
+	var rng *rand.Rand
+	if config.Seed != 0 {
+		rng = rand.New(rand.NewSource(config.Seed))
+	}
 
 	return &Game{
 		numPlayers:   config.NumPlayers,
 		targetScore:  config.TargetScore,
 		deckConfig:   config.DeckConfig,
 		rules:        config.Rules,
-		scores:       make([]int, numTeams),
+		scores:       make([]int, config.Rules.TeamCount()),
 		dealer:       0,
 		deck:         config.DeckConfig.CreateDeck(),
+		seed:         config.Seed,
+		rng:          rng,
 		roundHistory: make([]RoundResult, 0),
 	}
 }
 
-// StartRound begins a new round
+// StartRound begins a new round. In a seeded game the fresh deck is seeded
+// from the game's RNG, so the whole sequence of deals (including re-deals
+// after a misdeal) follows from GameConfig.Seed.
 func (g *Game) StartRound() {
+	var seed int64
+	if g.rng != nil {
+		seed = g.rng.Int63()
+	} else {
+		seed = rand.Int63()
+	}
+	g.deals++
+	g.deal(Board{Number: g.deals, Dealer: g.dealer, Seed: seed})
+}
+
+// StartBoard begins a new round dealt from a duplicate board instead of the
+// game's own deal sequence: the board's dealer deals a deck shuffled from
+// the board's seed. The game's RNG is left alone.
+func (g *Game) StartBoard(b Board) {
+	g.dealer = b.Dealer
+	g.deal(b)
+}
+
+// deal shuffles a fresh deck from the board's seed and deals the round
+func (g *Game) deal(b Board) {
+	g.board = b
 	g.deck = g.deckConfig.CreateDeck()
+	g.deck.Seed(b.Seed)
+	g.emit(RoundStartedEvent{Deal: b.Number, Dealer: g.dealer})
 	g.currentRound = NewRoundWithRules(g.numPlayers, g.dealer, g.rules)
 	g.currentRound.Deal(g.deck)
+	g.emit(DealtEvent{Dealer: g.dealer, TurnedCard: g.currentRound.TurnedCard()})
+}
+
+// Board returns the current round's deal as a duplicate board, so the same
+// cards can be dealt again at another table.
+func (g *Game) Board() Board {
+	return g.board
+}
+
+// Clone returns a fully independent copy of the game. A seeded clone deals
+// the same future hands as the original. Event subscribers are not copied, so
+// simulating on a clone never notifies the live game's listeners.
+func (g *Game) Clone() *Game {
+	c := &Game{
+		numPlayers:   g.numPlayers,
+		targetScore:  g.targetScore,
+		deckConfig:   g.deckConfig,
+		rules:        g.rules,
+		scores:       g.Scores(),
+		dealer:       g.dealer,
+		seed:         g.seed,
+		rng:          seededRNG(g.seed, g.deals),
+		deals:        g.deals,
+		board:        g.board,
+		undos:        g.undos,
+		roundHistory: g.RoundHistory(),
+	}
+	if g.deck != nil {
+		c.deck = &Deck{cards: append([]Card(nil), g.deck.cards...)}
+	}
+	if g.currentRound != nil {
+		c.currentRound = g.currentRound.Clone()
+	}
+	for _, h := range g.handHistory {
+		c.handHistory = append(c.handHistory, h.clone())
+	}
+	return c
+}
+
+// seededRNG returns the game RNG for seed advanced past the given number of
+// deals, or nil for an unseeded game.
+func seededRNG(seed int64, deals int) *rand.Rand {
+	if seed == 0 {
+		return nil
+	}
+	rng := rand.New(rand.NewSource(seed))
+	for i := 0; i < deals; i++ {
+		rng.Int63()
+	}
+	return rng
 }
 
 // Round returns the current round
@@ -100,11 +196,21 @@ func (g *Game) TargetScore() int {
 	return g.targetScore
 }
 
+// Seed returns the seed the game's deals are derived from (0 if unseeded).
+func (g *Game) Seed() int64 {
+	return g.seed
+}
+
 // Dealer returns the current dealer
 func (g *Game) Dealer() int {
 	return g.dealer
 }
 
+// Rules returns the rules the game is played under
+func (g *Game) Rules() Rules {
+	return g.rules
+}
+
 // StickTheDealer reports whether the stick-the-dealer rule is in effect.
 func (g *Game) StickTheDealer() bool {
 	return g.rules.StickTheDealer
@@ -115,6 +221,21 @@ func (g *Game) NumPlayers() int {
 	return g.numPlayers
 }
 
+// NumTeams returns the number of teams (one per player in Cutthroat)
+func (g *Game) NumTeams() int {
+	return len(g.scores)
+}
+
+// Team returns which team a player is on
+func (g *Game) Team(playerIdx int) int {
+	return TeamOf(playerIdx, g.NumTeams())
+}
+
+// DeckConfig returns the configuration every round's deck is built from
+func (g *Game) DeckConfig() DeckConfig {
+	return g.deckConfig
+}
+
 // Phase returns the current game phase
 func (g *Game) Phase() GamePhase {
 	if g.IsOver() {
@@ -140,6 +261,8 @@ func (g *Game) ApplyAction(action Action) error {
 		return PlayError("no round in progress")
 	}
 
+	phase := g.currentRound.Phase()
+	tricks := len(g.currentRound.trickHistory)
 	err := g.currentRound.ApplyAction(action)
 	if err != nil {
 		return err
@@ -150,10 +273,13 @@ func (g *Game) ApplyAction(action Action) error {
 		g.endRound()
 	}
 
+	g.emitActionEvents(action, phase, tricks)
 	return nil
 }
 
 func (g *Game) endRound() {
+	g.handHistory = append(g.handHistory, g.currentRound.History())
+
 	// A misdeal (round-2 throw-in) is not scored, not recorded, and the same
 	// dealer re-deals. Leave dealer and scores unchanged.
 	//
@@ -172,14 +298,51 @@ func (g *Game) endRound() {
 		g.scores[result.Makers] += result.MakerPoints
 	}
 	if result.DefendPoints > 0 {
-		defenderTeam := 1 - result.Makers
-		g.scores[defenderTeam] += result.DefendPoints
+		// Every team but the makers' defended; in Cutthroat that's both
+		// other players.
+		for team := range g.scores {
+			if team != result.Makers {
+				g.scores[team] += result.DefendPoints
+			}
+		}
 	}
 
 	// Advance dealer for next round
 	g.dealer = NextPlayer(g.dealer, g.numPlayers)
 }
 
+// Undo takes back the player's most recent decision in the current round,
+// rewinding the round to just before it along with every action applied
+// since, and emits a RewoundEvent. Once a round is over (and scored) it can
+// no longer be undone.
+func (g *Game) Undo(player int) error {
+	if g.currentRound == nil {
+		return PlayError("no round in progress")
+	}
+	if g.currentRound.IsComplete() {
+		return PlayError("the hand is already over")
+	}
+	log := g.currentRound.ActionLog()
+	for i := len(log) - 1; i >= 0; i-- {
+		if log[i].Player != player {
+			continue
+		}
+		if err := g.currentRound.Rewind(i); err != nil {
+			return err
+		}
+		g.undos++
+		g.emit(RewoundEvent{Player: player, Actions: i})
+		return nil
+	}
+	return PlayError("nothing to undo this hand")
+}
+
+// Undos returns how many decisions have been taken back this game, so
+// results from games with takebacks can be flagged or left out of stats.
+func (g *Game) Undos() int {
+	return g.undos
+}
+
 // IsOver returns true if the game is finished
 func (g *Game) IsOver() bool {
 	for _, score := range g.scores {
@@ -190,14 +353,17 @@ func (g *Game) IsOver() bool {
 	return false
 }
 
-// Winner returns the winning team (-1 if game not over)
+// Winner returns the winning team (-1 if game not over). When a euchre
+// carries more than one defending team past the target, the highest score
+// wins.
 func (g *Game) Winner() int {
+	winner := -1
 	for team, score := range g.scores {
-		if score >= g.targetScore {
-			return team
+		if score >= g.targetScore && (winner < 0 || score > g.scores[winner]) {
+			winner = team
 		}
 	}
-	return -1
+	return winner
 }
 
 // LegalActions returns all legal actions for the current player
@@ -216,6 +382,14 @@ func (g *Game) Hand(playerIdx int) []Card {
 	return g.currentRound.Hand(playerIdx)
 }
 
+// Discardable returns the cards the player to discard may throw away
+func (g *Game) Discardable() []Card {
+	if g.currentRound == nil {
+		return nil
+	}
+	return g.currentRound.Discardable()
+}
+
 // Trump returns the current trump suit
 func (g *Game) Trump() Suit {
 	if g.currentRound == nil {
@@ -239,6 +413,14 @@ func (g *Game) RoundHistory() []RoundResult {
 	return result
 }
 
+// HandHistories returns the full record (deal, bids, discard, plays) of every
+// finished round, including misdeals, oldest first.
+func (g *Game) HandHistories() []HandHistory {
+	result := make([]HandHistory, len(g.handHistory))
+	copy(result, g.handHistory)
+	return result
+}
+
 // NeedsNewRound returns true if we need to start a new round
 func (g *Game) NeedsNewRound() bool {
 	if g.IsOver() {
@@ -282,6 +464,11 @@ func (s *GameState) Score(team int) int {
 	return s.game.Score(team)
 }
 
+// Scores returns every team's score
+func (s *GameState) Scores() []int {
+	return s.game.Scores()
+}
+
 // TargetScore returns the winning score
 func (s *GameState) TargetScore() int {
 	return s.game.TargetScore()
@@ -312,6 +499,21 @@ func (s *GameState) NumPlayers() int {
 	return s.game.NumPlayers()
 }
 
+// NumTeams returns team count
+func (s *GameState) NumTeams() int {
+	return s.game.NumTeams()
+}
+
+// Team returns which team a player is on
+func (s *GameState) Team(playerIdx int) int {
+	return s.game.Team(playerIdx)
+}
+
+// IsPartner reports whether two different players are on the same team
+func (s *GameState) IsPartner(a, b int) bool {
+	return a != b && s.Team(a) == s.Team(b)
+}
+
 // Round returns the current round
 func (s *GameState) Round() *Round {
 	return s.game.Round()
@@ -321,3 +523,9 @@ func (s *GameState) Round() *Round {
 func (s *GameState) StickTheDealer() bool {
 	return s.game.StickTheDealer()
 }
+
+// MustCall reports whether the player to bid may not pass (see Round.MustCall).
+func (s *GameState) MustCall() bool {
+	r := s.game.Round()
+	return r != nil && r.MustCall()
+}
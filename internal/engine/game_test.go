@@ -258,3 +258,120 @@ func TestActionTypeString(t *testing.T) {
 		}
 	}
 }
+
+// playFirstLegal drives the current round to completion by always taking the
+// first non-pass action, so every round of a game is played deterministically.
+func playFirstLegal(t *testing.T, game *Game) {
+	t.Helper()
+	for !game.IsOver() && !game.NeedsNewRound() {
+		if err := game.ApplyAction(firstNonPassAction(game.LegalActions())); err != nil {
+			t.Fatalf("apply failed: %v", err)
+		}
+	}
+}
+
+func TestSeededGameReproducesEveryDeal(t *testing.T) {
+	config := DefaultGameConfig()
+	config.Seed = 12345
+
+	deals := func() [][]Card {
+		game := NewGame(config)
+		var out [][]Card
+		for i := 0; i < 4 && !game.IsOver(); i++ {
+			game.StartRound()
+			for p := 0; p < game.NumPlayers(); p++ {
+				out = append(out, game.Hand(p))
+			}
+			out = append(out, []Card{game.TurnedCard()})
+			playFirstLegal(t, game)
+		}
+		return out
+	}
+
+	first, second := deals(), deals()
+	if len(first) != len(second) {
+		t.Fatalf("seeded games dealt a different number of hands: %d vs %d", len(first), len(second))
+	}
+	for i := range first {
+		if len(first[i]) != len(second[i]) {
+			t.Fatalf("deal %d differs in size: %v vs %v", i, first[i], second[i])
+		}
+		for j := range first[i] {
+			if first[i][j] != second[i][j] {
+				t.Fatalf("deal %d differs: %v vs %v", i, first[i], second[i])
+			}
+		}
+	}
+}
+
+func TestSeededGameRoundsDifferFromEachOther(t *testing.T) {
+	config := DefaultGameConfig()
+	config.Seed = 99
+	game := NewGame(config)
+
+	game.StartRound()
+	firstHand := game.Hand(0)
+	playFirstLegal(t, game)
+	game.StartRound()
+	secondHand := game.Hand(0)
+
+	same := true
+	for i := range firstHand {
+		if firstHand[i] != secondHand[i] {
+			same = false
+		}
+	}
+	if same {
+		t.Error("consecutive rounds of a seeded game should not repeat the same deal")
+	}
+	if game.Seed() != 99 {
+		t.Errorf("Seed() = %d, want 99", game.Seed())
+	}
+}
+
+// TestCutthroatEuchrePaysBothOpponents plays three-handed games in which
+// everyone is their own team: nobody may go alone, and a euchred maker's two
+// opponents each score the defenders' points.
+func TestCutthroatEuchrePaysBothOpponents(t *testing.T) {
+	config := GameConfig{NumPlayers: 3, Rules: Rules{AllowMisdeal: true, Teams: 3}, Seed: 7}
+	game := NewGame(config)
+	if game.NumTeams() != 3 || len(game.Scores()) != 3 {
+		t.Fatalf("want 3 teams, got %d with scores %v", game.NumTeams(), game.Scores())
+	}
+
+	euchres := 0
+	for !game.IsOver() {
+		game.StartRound()
+		for _, a := range game.LegalActions() {
+			if up, ok := a.(OrderUpAction); ok && up.Alone {
+				t.Fatal("going alone offered with no partner to sit out")
+			}
+		}
+		before := game.Scores()
+		playFirstLegal(t, game)
+		if game.Round().IsMisdeal() {
+			continue
+		}
+		result := game.Round().Result()
+		if !result.WasEuchred {
+			continue
+		}
+		euchres++
+		after := game.Scores()
+		for team := range after {
+			want := result.DefendPoints
+			if team == result.Makers {
+				want = 0
+			}
+			if got := after[team] - before[team]; got != want {
+				t.Errorf("team %d scored %d on a euchre, want %d", team, got, want)
+			}
+		}
+	}
+	if euchres == 0 {
+		t.Error("no euchre in the game; pick another seed")
+	}
+	if w := game.Winner(); w < 0 || w >= 3 {
+		t.Errorf("winner = %d", w)
+	}
+}
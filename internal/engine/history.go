@@ -0,0 +1,176 @@
+package engine
+
+import "fmt"
+
+// ActionRecord is a concrete, serializable form of an applied Action. The
+// Action interface can't be decoded from JSON, so logs store records and
+// convert back with Action().
+type ActionRecord struct {
+	Type   ActionType `json:"type"`
+	Player int        `json:"player"`
+	Card   *Card      `json:"card,omitempty"`  // discard / play card
+	Suit   *Suit      `json:"suit,omitempty"`  // call trump
+	Alone  bool       `json:"alone,omitempty"` // order up / call trump
+}
+
+// RecordAction converts an action into its log record.
+func RecordAction(a Action) ActionRecord {
+	rec := ActionRecord{Type: a.Type(), Player: a.Player()}
+	switch act := a.(type) {
+	case OrderUpAction:
+		rec.Alone = act.Alone
+	case CallTrumpAction:
+		suit := act.Suit
+		rec.Suit = &suit
+		rec.Alone = act.Alone
+	case DiscardAction:
+		card := act.Card
+		rec.Card = &card
+	case PlayCardAction:
+		card := act.Card
+		rec.Card = &card
+	}
+	return rec
+}
+
+// Action converts the record back into the engine action it was made from.
+func (r ActionRecord) Action() (Action, error) {
+	switch r.Type {
+	case ActionPass:
+		return PassAction{PlayerIdx: r.Player}, nil
+	case ActionOrderUp:
+		return OrderUpAction{PlayerIdx: r.Player, Alone: r.Alone}, nil
+	case ActionCallTrump:
+		if r.Suit == nil {
+			return nil, PlayError("call trump record has no suit")
+		}
+		return CallTrumpAction{PlayerIdx: r.Player, Suit: *r.Suit, Alone: r.Alone}, nil
+	case ActionDefendAlone:
+		return DefendAloneAction{PlayerIdx: r.Player}, nil
+	case ActionDiscard:
+		if r.Card == nil {
+			return nil, PlayError("discard record has no card")
+		}
+		return DiscardAction{PlayerIdx: r.Player, Card: *r.Card}, nil
+	case ActionPlayCard:
+		if r.Card == nil {
+			return nil, PlayError("play card record has no card")
+		}
+		return PlayCardAction{PlayerIdx: r.Player, Card: *r.Card}, nil
+	default:
+		return nil, fmt.Errorf("unknown action type %d in record", r.Type)
+	}
+}
+
+// String returns a short human-readable description, e.g. "1 Call Trump ♠ alone".
+func (r ActionRecord) String() string {
+	s := fmt.Sprintf("%d %s", r.Player, r.Type)
+	if r.Suit != nil {
+		s += " " + r.Suit.Symbol()
+	}
+	if r.Card != nil {
+		s += " " + r.Card.String()
+	}
+	if r.Alone {
+		s += " alone"
+	}
+	return s
+}
+
+// HandHistory is the complete record of one round: the deal, the turned card
+// and every action applied, in order. Replaying it reproduces the round
+// exactly, including the bidding sequence and the dealer's discard that
+// TrickHistory does not keep.
+type HandHistory struct {
+	NumPlayers int            `json:"numPlayers"`
+	Dealer     int            `json:"dealer"`
+	Rules      Rules          `json:"rules"`
+	Deal       [][]Card       `json:"deal"`             // each seat's cards as dealt, indexed by player
+	Stacks     [][]Stack      `json:"stacks,omitempty"` // each seat's stacks as dealt, if the rules deal any
+	TurnedCard Card           `json:"turnedCard"`
+	Actions    []ActionRecord `json:"actions"`
+	Pack       []Card         `json:"pack,omitempty"` // the deck dealt from; omitted means the standard 24 cards
+}
+
+// Replay rebuilds the round from the deal and applies the first n recorded
+// actions (all of them if n is negative or past the end).
+func (h HandHistory) Replay(n int) (*Round, error) {
+	if len(h.Deal) != h.NumPlayers {
+		return nil, fmt.Errorf("hand history for %d players has %d dealt hands", h.NumPlayers, len(h.Deal))
+	}
+	if n < 0 || n > len(h.Actions) {
+		n = len(h.Actions)
+	}
+
+	r := NewRoundWithRules(h.NumPlayers, h.Dealer, h.Rules)
+	r.pack = append([]Card(nil), h.Pack...)
+	r.dealHands(h.Deal, h.Stacks, h.TurnedCard)
+	for i, rec := range h.Actions[:n] {
+		action, err := rec.Action()
+		if err != nil {
+			return nil, fmt.Errorf("action %d: %w", i+1, err)
+		}
+		if err := r.ApplyAction(action); err != nil {
+			return nil, fmt.Errorf("action %d (%s): %w", i+1, rec, err)
+		}
+	}
+	return r, nil
+}
+
+// Rewind returns the round to the state it was in after its first n recorded
+// actions, as if the later ones had never been applied. The round is rebuilt
+// by replaying its own history, so every derived field (hands, trick, turn,
+// bid round) is restored exactly.
+func (r *Round) Rewind(n int) error {
+	if n < 0 || n > len(r.actionLog) {
+		return fmt.Errorf("cannot rewind to action %d of %d", n, len(r.actionLog))
+	}
+	replayed, err := r.History().Replay(n)
+	if err != nil {
+		return err
+	}
+	*r = *replayed
+	return nil
+}
+
+// copyActionLog returns an independent copy of an action log, including the
+// cards and suits the records point to.
+func copyActionLog(log []ActionRecord) []ActionRecord {
+	if log == nil {
+		return nil
+	}
+	out := make([]ActionRecord, len(log))
+	for i, rec := range log {
+		if rec.Card != nil {
+			card := *rec.Card
+			rec.Card = &card
+		}
+		if rec.Suit != nil {
+			suit := *rec.Suit
+			rec.Suit = &suit
+		}
+		out[i] = rec
+	}
+	return out
+}
+
+// clone returns an independent copy of the hand history.
+func (h HandHistory) clone() HandHistory {
+	h.Deal = copyDeal(h.Deal)
+	h.Stacks = copyStacks(h.Stacks)
+	h.Actions = copyActionLog(h.Actions)
+	h.Pack = append([]Card(nil), h.Pack...)
+	return h
+}
+
+// copyDeal returns an independent copy of a per-seat deal.
+func copyDeal(deal [][]Card) [][]Card {
+	if deal == nil {
+		return nil
+	}
+	out := make([][]Card, len(deal))
+	for i, hand := range deal {
+		out[i] = append([]Card(nil), hand...)
+	}
+	return out
+}
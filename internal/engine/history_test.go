@@ -0,0 +1,220 @@
+package engine
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// orderedUpRound deals a seeded round in which the first bidder passes, the
+// second orders up and the dealer discards, leaving the round ready to play.
+func orderedUpRound(t *testing.T) *Round {
+	t.Helper()
+	round := NewRound(4, 0)
+	deck := NewStandardDeck()
+	deck.Seed(11)
+	round.Deal(deck)
+
+	steps := []Action{
+		PassAction{PlayerIdx: 1},
+		OrderUpAction{PlayerIdx: 2},
+	}
+	for _, a := range steps {
+		if err := round.ApplyAction(a); err != nil {
+			t.Fatalf("%s failed: %v", a.Type(), err)
+		}
+	}
+	if err := round.ApplyAction(DiscardAction{PlayerIdx: 0, Card: round.Hand(0)[0]}); err != nil {
+		t.Fatalf("discard failed: %v", err)
+	}
+	return round
+}
+
+func TestActionLogRecordsBiddingAndDiscard(t *testing.T) {
+	round := orderedUpRound(t)
+
+	log := round.ActionLog()
+	wantTypes := []ActionType{ActionPass, ActionOrderUp, ActionDiscard}
+	if len(log) != len(wantTypes) {
+		t.Fatalf("log has %d entries, want %d: %v", len(log), len(wantTypes), log)
+	}
+	for i, want := range wantTypes {
+		if log[i].Type != want {
+			t.Errorf("entry %d type = %s, want %s", i, log[i].Type, want)
+		}
+	}
+	if log[2].Card == nil {
+		t.Error("discard entry should record the discarded card")
+	}
+}
+
+func TestActionLogSkipsRejectedActions(t *testing.T) {
+	round := orderedUpRound(t)
+	before := len(round.ActionLog())
+
+	// Seat 3 is not on lead.
+	if err := round.ApplyAction(PlayCardAction{PlayerIdx: 3, Card: round.Hand(3)[0]}); err == nil {
+		t.Fatal("out-of-turn play should be rejected")
+	}
+	if got := len(round.ActionLog()); got != before {
+		t.Errorf("rejected action was logged: %d entries, want %d", got, before)
+	}
+}
+
+func TestHistoryRecordsTheDealBeforeThePickUp(t *testing.T) {
+	round := orderedUpRound(t)
+	h := round.History()
+
+	if h.TurnedCard != round.TurnedCard() {
+		t.Errorf("history turned card = %v, want %v", h.TurnedCard, round.TurnedCard())
+	}
+	if len(h.Deal[0]) != 5 {
+		t.Fatalf("dealer was dealt %d cards, want 5", len(h.Deal[0]))
+	}
+	for _, c := range h.Deal[0] {
+		if c == h.TurnedCard {
+			t.Error("the dealer's recorded deal should not include the picked-up card")
+		}
+	}
+}
+
+func TestReplayReproducesRound(t *testing.T) {
+	round := orderedUpRound(t)
+	for round.Phase() == PhasePlay {
+		if err := round.ApplyAction(round.LegalActions()[0]); err != nil {
+			t.Fatalf("play failed: %v", err)
+		}
+	}
+
+	replayed, err := round.History().Replay(-1)
+	if err != nil {
+		t.Fatalf("replay failed: %v", err)
+	}
+	if !reflect.DeepEqual(round.Snapshot(), replayed.Snapshot()) {
+		t.Errorf("replayed round differs from the original")
+	}
+
+	// A partial replay stops right after the order-up.
+	partial, err := round.History().Replay(2)
+	if err != nil {
+		t.Fatalf("partial replay failed: %v", err)
+	}
+	if partial.Phase() != PhaseDiscard {
+		t.Errorf("replay of 2 actions should stop in the discard phase, got %s", partial.Phase())
+	}
+}
+
+func TestHandHistoryJSONRoundTrip(t *testing.T) {
+	h := orderedUpRound(t).History()
+
+	data, err := json.Marshal(h)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	var decoded HandHistory
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	if !reflect.DeepEqual(h, decoded) {
+		t.Errorf("hand history changed across JSON:\n%+v\n%+v", h, decoded)
+	}
+}
+
+func TestGameKeepsHandHistoriesIncludingMisdeals(t *testing.T) {
+	game := NewGame(GameConfig{Rules: Rules{AllowMisdeal: true}, Seed: 4})
+	game.StartRound()
+	for i := 0; i < 8; i++ {
+		_ = game.ApplyAction(PassAction{PlayerIdx: game.CurrentPlayer()})
+	}
+	if !game.IsMisdeal() {
+		t.Fatal("expected a misdeal")
+	}
+	game.StartRound()
+	playFirstLegal(t, game)
+
+	hands := game.HandHistories()
+	if len(hands) != 2 {
+		t.Fatalf("got %d hand histories, want 2 (misdeal + played hand)", len(hands))
+	}
+	if len(hands[0].Actions) != 8 {
+		t.Errorf("misdeal history has %d actions, want 8 passes", len(hands[0].Actions))
+	}
+	if len(game.RoundHistory()) != 1 {
+		t.Errorf("misdeals must still be left out of the scored round history")
+	}
+}
+
+func TestRewindRestoresEarlierState(t *testing.T) {
+	round := orderedUpRound(t)
+	before := round.Snapshot()
+	for i := 0; i < 3; i++ {
+		if err := round.ApplyAction(round.LegalActions()[0]); err != nil {
+			t.Fatalf("play failed: %v", err)
+		}
+	}
+
+	if err := round.Rewind(len(before.ActionLog)); err != nil {
+		t.Fatalf("rewind failed: %v", err)
+	}
+	if !reflect.DeepEqual(before, round.Snapshot()) {
+		t.Errorf("rewound round differs from the state before the plays")
+	}
+	if err := round.Rewind(len(round.ActionLog()) + 1); err == nil {
+		t.Error("rewinding past the end of the log should fail")
+	}
+}
+
+func TestGameUndoTakesBackPlayersLastDecision(t *testing.T) {
+	game := NewGame(GameConfig{Seed: 21})
+	game.StartRound()
+	for game.Phase() != PhasePlay {
+		if err := game.ApplyAction(firstNonPassAction(game.LegalActions())); err != nil {
+			t.Fatalf("apply failed: %v", err)
+		}
+	}
+
+	// Play until seat 0 has played a card and the turn has moved on.
+	var played bool
+	for !played || game.CurrentPlayer() == 0 {
+		player := game.CurrentPlayer()
+		if err := game.ApplyAction(game.LegalActions()[0]); err != nil {
+			t.Fatalf("play failed: %v", err)
+		}
+		played = played || player == 0
+	}
+	log := game.Round().ActionLog()
+	var last int
+	for i, rec := range log {
+		if rec.Player == 0 {
+			last = i
+		}
+	}
+	hand := len(game.Hand(0))
+
+	if err := game.Undo(0); err != nil {
+		t.Fatalf("undo failed: %v", err)
+	}
+	if got := len(game.Round().ActionLog()); got != last {
+		t.Errorf("undo left %d actions, want %d (everything from seat 0's play on removed)", got, last)
+	}
+	if game.CurrentPlayer() != 0 {
+		t.Errorf("after undo it should be seat 0's turn, got %d", game.CurrentPlayer())
+	}
+	if got := len(game.Hand(0)); got != hand+1 {
+		t.Errorf("seat 0 holds %d cards after undo, want %d", got, hand+1)
+	}
+	if game.Undos() != 1 {
+		t.Errorf("Undos() = %d, want 1", game.Undos())
+	}
+}
+
+func TestGameUndoWithNothingToTakeBack(t *testing.T) {
+	game := NewGame(GameConfig{Seed: 21})
+	game.StartRound()
+	if err := game.Undo(game.CurrentPlayer()); err == nil {
+		t.Error("undo before any decision should fail")
+	}
+	if game.Undos() != 0 {
+		t.Errorf("a failed undo must not be counted, got %d", game.Undos())
+	}
+}
@@ -1,5 +1,7 @@
 package engine
 
+import "fmt"
+
 // GamePhase represents the current phase of a round
 type GamePhase int
 
@@ -7,7 +9,7 @@ const (
 	PhaseDeal        GamePhase = iota
 	PhaseBidRound1             // Order up or pass
 	PhaseBidRound2             // Name trump or pass (if all passed round 1)
-	PhaseDiscard               // Dealer discards if trump was ordered up
+	PhaseDiscard               // Dealer discards if trump was ordered up; a lone maker after an exchange
 	PhaseDefendAlone           // Lone-maker hand: poll defenders for defend-alone (optional rule)
 	PhasePlay                  // Card play
 	PhaseTrickEnd              // Brief pause after trick ends
@@ -40,6 +42,22 @@ func (p GamePhase) String() string {
 	}
 }
 
+// MarshalText encodes the phase by name so snapshots stay readable.
+func (p GamePhase) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText decodes a phase name written by MarshalText.
+func (p *GamePhase) UnmarshalText(text []byte) error {
+	for candidate := PhaseDeal; candidate <= PhaseGameEnd; candidate++ {
+		if candidate.String() == string(text) {
+			*p = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown phase %q", text)
+}
+
 // ActionType represents the type of action a player can take
 type ActionType int
 
@@ -74,6 +92,22 @@ func (a ActionType) String() string {
 	}
 }
 
+// MarshalText encodes the action type by name so logs stay readable.
+func (a ActionType) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText decodes an action type name written by MarshalText.
+func (a *ActionType) UnmarshalText(text []byte) error {
+	for candidate := ActionPass; candidate <= ActionPlayCard; candidate++ {
+		if candidate.String() == string(text) {
+			*a = candidate
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown action type %q", text)
+}
+
 // Action represents an action a player can take
 type Action interface {
 	Type() ActionType
@@ -144,27 +178,49 @@ type BidDecision struct {
 
 // RoundResult contains the outcome of a completed round
 type RoundResult struct {
-	Makers           int  // Team that called trump (0 or 1)
-	MakerTricks      int  // Tricks won by making team
-	WasAlone         bool // Whether it was a loner attempt
-	WasEuchred       bool // Whether makers were euchred
-	MakerPoints      int  // Points scored by makers
-	DefendPoints     int  // Points scored by defenders (if euchred)
-	WasDefendedAlone bool // Whether a defender declared defend-alone
+	Makers           int  `json:"makers"`                     // Team that called trump
+	MakerTricks      int  `json:"makerTricks"`                // Tricks won by making team
+	WasAlone         bool `json:"wasAlone,omitempty"`         // Whether it was a loner attempt
+	WasEuchred       bool `json:"wasEuchred,omitempty"`       // Whether makers were euchred
+	MakerPoints      int  `json:"makerPoints"`                // Points scored by makers
+	DefendPoints     int  `json:"defendPoints"`               // Points scored by each defending team (if euchred)
+	WasDefendedAlone bool `json:"wasDefendedAlone,omitempty"` // Whether a defender declared defend-alone
 }
 
 // ScoreUpdate represents point changes after a round
 type ScoreUpdate struct {
 	Team0Delta int
 	Team1Delta int
+	Team2Delta int // only at tables with a third team, e.g. Cutthroat
+}
+
+// Delta returns the point change for a team
+func (u ScoreUpdate) Delta(team int) int {
+	switch team {
+	case 0:
+		return u.Team0Delta
+	case 1:
+		return u.Team1Delta
+	case 2:
+		return u.Team2Delta
+	default:
+		return 0
+	}
 }
 
 // Team returns which team a player is on (0 or 1)
-// In 4-player Euchre: players 0,2 are team 0; players 1,3 are team 1
+// In 4-player Euchre: players 0,2 are team 0; players 1,3 are team 1.
+// Tables seated differently use Round.Team or TeamOf.
 func Team(playerIdx int) int {
 	return playerIdx % 2
 }
 
+// TeamOf returns which of numTeams teams a player is on, with teams seated
+// alternately round the table
+func TeamOf(playerIdx, numTeams int) int {
+	return playerIdx % numTeams
+}
+
 // Partner returns the partner's player index
 // In 4-player Euchre: 0<->2, 1<->3
 func Partner(playerIdx int) int {
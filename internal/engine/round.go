@@ -1,5 +1,18 @@
 package engine
 
+import (
+	"fmt"
+	"slices"
+)
+
+// Stack is a face-down card dealt in front of a player with a face-up card
+// on top of it (see Rules.Stacks). The face-up card is in its owner's hand;
+// playing it turns the face-down card up into the hand.
+type Stack struct {
+	Down Card `json:"down"`
+	Up   Card `json:"up"`
+}
+
 // Round represents a single round of Euchre (one deal until scoring)
 type Round struct {
 	// Configuration
@@ -27,11 +40,16 @@ type Round struct {
 
 	// Cards
 	hands        []*Hand
+	stacks       [][]Stack // each seat's stacks whose face-up card is still on the table
 	currentTrick *Trick
 	tricksWon    []int // Tricks won by each player
 
 	// History
 	trickHistory []TrickResult
+	pack         []Card         // every card in the deck the round was dealt from
+	deal         [][]Card       // each seat's hand as dealt
+	dealtStacks  [][]Stack      // each seat's stacks as dealt
+	actionLog    []ActionRecord // every successfully applied action, in order
 }
 
 // NewRound creates a new round with the given dealer using the default rules.
@@ -53,7 +71,7 @@ func NewRoundWithRules(numPlayers, dealer int, rules Rules) *Round {
 		defendAlonePoll: -1,
 		hands:           make([]*Hand, numPlayers),
 		tricksWon:       make([]int, numPlayers),
-		trickHistory:    make([]TrickResult, 0, 5),
+		trickHistory:    make([]TrickResult, 0, rules.TrickCount()),
 	}
 
 	for i := 0; i < numPlayers; i++ {
@@ -63,6 +81,53 @@ func NewRoundWithRules(numPlayers, dealer int, rules Rules) *Round {
 	return r
 }
 
+// Clone returns a fully independent copy of the round: applying actions to
+// the copy never affects the original, so an AI can search hypothetical lines
+// from the live position.
+func (r *Round) Clone() *Round {
+	c := *r
+	c.hands = make([]*Hand, len(r.hands))
+	for i, h := range r.hands {
+		c.hands[i] = h.Clone()
+	}
+	if r.currentTrick != nil {
+		c.currentTrick = r.currentTrick.Clone()
+	}
+	c.stacks = copyStacks(r.stacks)
+	c.tricksWon = append([]int(nil), r.tricksWon...)
+	c.trickHistory = copyTrickResults(r.trickHistory)
+	c.deal = copyDeal(r.deal)
+	c.dealtStacks = copyStacks(r.dealtStacks)
+	c.actionLog = copyActionLog(r.actionLog)
+	return &c
+}
+
+// WithHands returns a clone of the round with every seat's current hand
+// replaced, for searching a guessed layout of the cards a player can't see.
+// Each seat must receive as many cards as it has left to play: its hand,
+// face-up stack cards included, followed by a card for each stack, which
+// goes face down beneath it. The copy's History still describes the real
+// deal, so it can't be replayed.
+func (r *Round) WithHands(hands [][]Card) (*Round, error) {
+	if len(hands) != r.numPlayers {
+		return nil, fmt.Errorf("got %d hands for %d players", len(hands), r.numPlayers)
+	}
+	for i, h := range hands {
+		if want := r.CardsLeft(i); len(h) != want {
+			return nil, fmt.Errorf("seat %d has %d cards to play, got %d", i, want, len(h))
+		}
+	}
+	c := r.Clone()
+	for i, h := range hands {
+		held := r.hands[i].Size()
+		c.hands[i] = NewHandWith(h[:held])
+		for k := range c.Stacks(i) {
+			c.stacks[i][k].Down = h[held+k]
+		}
+	}
+	return c, nil
+}
+
 // IsMisdeal returns true if the round ended as a throw-in (all passed in round 2
 // with stick-the-dealer off). A misdeal is not scored and does not rotate the dealer.
 func (r *Round) IsMisdeal() bool {
@@ -76,6 +141,7 @@ func (r *Round) IsMisdeal() bool {
 // packet sizes ({2,3,2,3} then the complement {3,2,3,2}) that only sum to 5 per
 // player when there are exactly 4 players. For any other player count we fall
 // back to a simple uniform deal (3 then 2) so each player still receives 5.
+// Stacks, when the rules deal them, go down before either pass (see Deal).
 func dealPasses(numPlayers int) (first, second []int) {
 	first = make([]int, numPlayers)
 	second = make([]int, numPlayers)
@@ -93,11 +159,31 @@ func dealPasses(numPlayers int) (first, second []int) {
 
 // Deal deals cards from the deck to all players
 func (r *Round) Deal(deck *Deck) {
+	r.pack = deck.Cards()
 	deck.Shuffle()
 
 	// Deal ORDER is preserved: left of dealer first ... dealer last.
 	firstPass, secondPass := dealPasses(r.numPlayers)
 
+	// Stacks go down first: a row of face-down cards to each player, then a
+	// face-up card on each of them. The face-up cards join the hand.
+	if r.rules.Stacks > 0 {
+		r.stacks = make([][]Stack, r.numPlayers)
+		for i := 0; i < r.numPlayers; i++ {
+			playerIdx := NextPlayer(r.dealer+i, r.numPlayers)
+			for _, c := range deck.DrawN(r.rules.Stacks) {
+				r.stacks[playerIdx] = append(r.stacks[playerIdx], Stack{Down: c})
+			}
+		}
+		for i := 0; i < r.numPlayers; i++ {
+			playerIdx := NextPlayer(r.dealer+i, r.numPlayers)
+			for k, c := range deck.DrawN(r.rules.Stacks) {
+				r.stacks[playerIdx][k].Up = c
+				r.hands[playerIdx].Add(c)
+			}
+		}
+	}
+
 	for i := 0; i < r.numPlayers; i++ {
 		playerIdx := NextPlayer(r.dealer+i, r.numPlayers)
 		r.hands[playerIdx].AddAll(deck.DrawN(firstPass[i]))
@@ -113,9 +199,38 @@ func (r *Round) Deal(deck *Deck) {
 		r.turnedCard = turnedCard
 	}
 
+	r.startBidding()
+}
+
+// dealHands gives each seat a known hand and stacks instead of dealing from a
+// deck. Used to replay a recorded hand.
+func (r *Round) dealHands(hands [][]Card, stacks [][]Stack, turnedCard Card) {
+	for i, cards := range hands {
+		r.hands[i] = NewHandWith(cards)
+	}
+	r.stacks = copyStacks(stacks)
+	r.turnedCard = turnedCard
+	r.startBidding()
+}
+
+// startBidding records the deal and opens round-1 bidding left of the dealer.
+// A turned-up Benny can't be ordered up as a suit, so it goes straight to
+// the dealer, who must name trump and take it.
+func (r *Round) startBidding() {
+	r.deal = make([][]Card, r.numPlayers)
+	for i, h := range r.hands {
+		r.deal[i] = h.Cards()
+	}
+	r.dealtStacks = copyStacks(r.stacks)
+
 	r.phase = PhaseBidRound1
 	r.bidRound = 1
 	r.currentBidder = NextPlayer(r.dealer, r.numPlayers)
+	if r.turnedCard.IsJoker() {
+		r.phase = PhaseBidRound2
+		r.bidRound = 2
+		r.currentBidder = r.dealer
+	}
 }
 
 // Phase returns the current game phase
@@ -123,6 +238,16 @@ func (r *Round) Phase() GamePhase {
 	return r.phase
 }
 
+// NumPlayers returns the number of seats at the table
+func (r *Round) NumPlayers() int {
+	return r.numPlayers
+}
+
+// Rules returns the optional rules the round is played under
+func (r *Round) Rules() Rules {
+	return r.rules
+}
+
 // Dealer returns the dealer's player index
 func (r *Round) Dealer() int {
 	return r.dealer
@@ -138,6 +263,35 @@ func (r *Round) TurnedCard() Card {
 	return r.turnedCard
 }
 
+// Pack returns every card in the deck the round was dealt from, including
+// the ones left in the kitty. A round that was never dealt from a deck
+// reports the standard 24-card pack.
+func (r *Round) Pack() []Card {
+	if r.pack == nil {
+		return NewStandardDeck().Cards()
+	}
+	return append([]Card(nil), r.pack...)
+}
+
+// packRecord returns the pack for a history or snapshot to keep, or nil for
+// the standard 24 cards, which is what a missing pack means.
+func (r *Round) packRecord() []Card {
+	if r.pack == nil || slices.Equal(r.pack, NewStandardDeck().cards) {
+		return nil
+	}
+	return append([]Card(nil), r.pack...)
+}
+
+// MustCall reports whether the player to bid may not pass and must name
+// trump: the dealer at the end of round 2 under stick-the-dealer, or a
+// dealer who turned up the Benny.
+func (r *Round) MustCall() bool {
+	if r.phase != PhaseBidRound2 || r.currentBidder != r.dealer {
+		return false
+	}
+	return r.rules.StickTheDealer || r.turnedCard.IsJoker()
+}
+
 // Maker returns the player who called trump (-1 if none)
 func (r *Round) Maker() int {
 	return r.maker
@@ -153,13 +307,18 @@ func (r *Round) IsAlone() bool {
 	return r.alone
 }
 
+// AloneDefender returns the player defending alone (-1 if none)
+func (r *Round) AloneDefender() int {
+	return r.aloneDefender
+}
+
 // CurrentPlayer returns whose turn it is
 func (r *Round) CurrentPlayer() int {
 	switch r.phase {
 	case PhaseBidRound1, PhaseBidRound2:
 		return r.currentBidder
 	case PhaseDiscard:
-		return r.dealer
+		return r.discarder()
 	case PhaseDefendAlone:
 		return r.defendAlonePoll
 	case PhasePlay:
@@ -202,14 +361,20 @@ func (r *Round) nextToPlay() int {
 		return r.findFirstLeader()
 	}
 
-	playedPlayers := make(map[int]bool)
-	for _, pc := range r.currentTrick.Cards() {
-		playedPlayers[pc.Player] = true
+	// Called for every card of every playout a search AI runs, so this scans
+	// the trick rather than building a set of who has played.
+	played := func(p int) bool {
+		for _, pc := range r.currentTrick.cards {
+			if pc.Player == p {
+				return true
+			}
+		}
+		return false
 	}
 
 	current := leader
 	for i := 0; i < r.numPlayers; i++ {
-		if !playedPlayers[current] && !r.isSittingOut(current) {
+		if !played(current) && !r.isSittingOut(current) {
 			return current
 		}
 		current = NextPlayer(current, r.numPlayers)
@@ -219,12 +384,12 @@ func (r *Round) nextToPlay() int {
 }
 
 // isSittingOut returns true if the player is sitting out: the lone maker's
-// partner, and (if a defender declared defend-alone) the lone defender's partner.
+// partners, and (if a defender declared defend-alone) the lone defender's partners.
 func (r *Round) isSittingOut(p int) bool {
-	if r.alone && p == Partner(r.maker) {
+	if r.alone && r.IsPartner(p, r.maker) {
 		return true
 	}
-	if r.aloneDefender >= 0 && p == Partner(r.aloneDefender) {
+	if r.aloneDefender >= 0 && r.IsPartner(p, r.aloneDefender) {
 		return true
 	}
 	return false
@@ -249,6 +414,51 @@ func (r *Round) Hand(playerIdx int) []Card {
 	return r.hands[playerIdx].Cards()
 }
 
+// Stacks returns a player's stacks whose face-up card is still on the table
+func (r *Round) Stacks(playerIdx int) []Stack {
+	if playerIdx < 0 || playerIdx >= len(r.stacks) {
+		return nil
+	}
+	return append([]Stack(nil), r.stacks[playerIdx]...)
+}
+
+// CardsLeft returns how many cards a player has still to play: the hand
+// and the face-down cards under their stacks
+func (r *Round) CardsLeft(playerIdx int) int {
+	left := r.hands[playerIdx].Size()
+	if playerIdx < len(r.stacks) {
+		left += len(r.stacks[playerIdx])
+	}
+	return left
+}
+
+// Discardable returns the cards the player to discard may throw away: their
+// hand, less the face-up stack cards, which stay on the table
+func (r *Round) Discardable() []Card {
+	discarder := r.discarder()
+	var cards []Card
+	for _, c := range r.hands[discarder].Cards() {
+		if r.stackUnder(discarder, c) < 0 {
+			cards = append(cards, c)
+		}
+	}
+	return cards
+}
+
+// stackUnder returns the index of the player's stack that card covers, or
+// -1 if it is not a face-up stack card
+func (r *Round) stackUnder(playerIdx int, card Card) int {
+	if playerIdx >= len(r.stacks) {
+		return -1
+	}
+	for i, st := range r.stacks[playerIdx] {
+		if st.Up == card {
+			return i
+		}
+	}
+	return -1
+}
+
 // TricksWon returns how many tricks a player has won
 func (r *Round) TricksWon(playerIdx int) int {
 	if playerIdx < 0 || playerIdx >= len(r.tricksWon) {
@@ -257,11 +467,37 @@ func (r *Round) TricksWon(playerIdx int) int {
 	return r.tricksWon[playerIdx]
 }
 
+// NumTeams returns how many teams the seats are split into
+func (r *Round) NumTeams() int {
+	return r.rules.TeamCount()
+}
+
+// Team returns which team a player is on at this table
+func (r *Round) Team(playerIdx int) int {
+	return TeamOf(playerIdx, r.NumTeams())
+}
+
+// IsPartner reports whether two different players are on the same team
+func (r *Round) IsPartner(a, b int) bool {
+	return a != b && r.Team(a) == r.Team(b)
+}
+
+// HasPartner reports whether a player has anyone on their team, and so
+// whether going or defending alone means anything
+func (r *Round) HasPartner(playerIdx int) bool {
+	for p := 0; p < r.numPlayers; p++ {
+		if r.IsPartner(p, playerIdx) {
+			return true
+		}
+	}
+	return false
+}
+
 // TeamTricksWon returns how many tricks a team has won
 func (r *Round) TeamTricksWon(team int) int {
 	total := 0
 	for i := 0; i < r.numPlayers; i++ {
-		if Team(i) == team {
+		if r.Team(i) == team {
 			total += r.tricksWon[i]
 		}
 	}
@@ -281,24 +517,31 @@ func (r *Round) Trick() *Trick {
 	return r.currentTrick
 }
 
-// ApplyAction applies a player action to the round
+// ApplyAction applies a player action to the round. Successful actions are
+// appended to the round's action log; rejected ones leave no trace.
 func (r *Round) ApplyAction(action Action) error {
+	var err error
 	switch a := action.(type) {
 	case PassAction:
-		return r.handlePass(a)
+		err = r.handlePass(a)
 	case OrderUpAction:
-		return r.handleOrderUp(a)
+		err = r.handleOrderUp(a)
 	case CallTrumpAction:
-		return r.handleCallTrump(a)
+		err = r.handleCallTrump(a)
 	case DefendAloneAction:
-		return r.handleDefendAlone(a)
+		err = r.handleDefendAlone(a)
 	case DiscardAction:
-		return r.handleDiscard(a)
+		err = r.handleDiscard(a)
 	case PlayCardAction:
-		return r.handlePlayCard(a)
+		err = r.handlePlayCard(a)
 	default:
-		return PlayError("unknown action type")
+		err = PlayError("unknown action type")
+	}
+	if err != nil {
+		return err
 	}
+	r.actionLog = append(r.actionLog, RecordAction(action))
+	return nil
 }
 
 func (r *Round) handlePass(action PassAction) error {
@@ -326,7 +569,10 @@ func (r *Round) handlePass(action PassAction) error {
 	}
 
 	// Stick-the-dealer: the dealer may not pass in round 2 and must name trump.
-	if r.phase == PhaseBidRound2 && r.rules.StickTheDealer && r.currentBidder == r.dealer {
+	if r.MustCall() {
+		if r.turnedCard.IsJoker() {
+			return PlayError("the dealer turned up the Benny and must call trump")
+		}
 		return PlayError("stick-the-dealer: dealer must call trump and cannot pass")
 	}
 
@@ -371,8 +617,10 @@ func (r *Round) handleOrderUp(action OrderUpAction) error {
 	// Set trump to the turned card's suit
 	r.trump = r.turnedCard.Suit
 	r.maker = action.PlayerIdx
-	r.makerTeam = Team(action.PlayerIdx)
-	r.alone = action.Alone
+	r.makerTeam = r.Team(action.PlayerIdx)
+	// With no partner to sit out (Cutthroat), every hand is played alone and
+	// scored as an ordinary one.
+	r.alone = action.Alone && r.HasPartner(action.PlayerIdx)
 
 	// Dealer picks up the turned card
 	r.hands[r.dealer].Add(r.turnedCard)
@@ -396,23 +644,91 @@ func (r *Round) handleCallTrump(action CallTrumpAction) error {
 
 	r.trump = action.Suit
 	r.maker = action.PlayerIdx
-	r.makerTeam = Team(action.PlayerIdx)
-	r.alone = action.Alone
+	r.makerTeam = r.Team(action.PlayerIdx)
+	r.alone = action.Alone && r.HasPartner(action.PlayerIdx)
 
-	// Sanity check: everyone should have exactly 5 cards before play starts
-	for i := 0; i < r.numPlayers; i++ {
-		if r.hands[i].Size() != 5 {
-			return PlayError("all players must have 5 cards before play phase")
-		}
+	if err := r.checkHandSizes(); err != nil {
+		return err
 	}
 
-	// No discard phase in round 2. Either open the defend-alone declaration
-	// window (lone maker + rule on) or go straight to play.
-	r.beginPostTrump()
+	// A turned-up Benny is the dealer's whatever suit they name, so they pick
+	// it up and discard as if it had been ordered up.
+	if r.turnedCard.IsJoker() {
+		r.hands[r.dealer].Add(r.turnedCard)
+		r.phase = PhaseDiscard
+		return nil
+	}
+
+	// No dealer's discard in round 2. A lone maker may still take cards from
+	// their partners; then either open the defend-alone declaration window
+	// (lone maker + rule on) or go straight to play.
+	r.beginExchange()
 
 	return nil
 }
 
+// beginExchange runs once the dealer has discarded, or straight after a
+// round-2 call. Under Rules.LonerExchange a lone maker takes each partner's
+// best card and then discards as many; otherwise it moves on to
+// beginPostTrump.
+func (r *Round) beginExchange() {
+	if r.exchangeWithLoner() {
+		r.phase = PhaseDiscard
+		return
+	}
+	r.beginPostTrump()
+}
+
+// exchangeWithLoner has each partner of a lone maker, from the maker's left,
+// pass them their best card: the highest trump, or failing that the highest
+// card in another suit. It reports whether any cards changed hands, which
+// happens at most once a round.
+func (r *Round) exchangeWithLoner() bool {
+	if !r.rules.LonerExchange || !r.alone || r.Exchanged() {
+		return false
+	}
+	for i := 1; i < r.numPlayers; i++ {
+		p := (r.maker + i) % r.numPlayers
+		if !r.IsPartner(p, r.maker) {
+			continue
+		}
+		cards := r.hands[p].Cards()
+		best := cards[0]
+		for _, c := range cards[1:] {
+			if cardPower(c, r.trump) > cardPower(best, r.trump) {
+				best = c
+			}
+		}
+		r.hands[p].Remove(best)
+		r.hands[r.maker].Add(best)
+	}
+	return true
+}
+
+// Exchanged reports whether a lone maker has taken their partners' best
+// cards (see Rules.LonerExchange). A partner who has passed a card holds a
+// short hand for the rest of the round.
+func (r *Round) Exchanged() bool {
+	if !r.rules.LonerExchange || !r.alone {
+		return false
+	}
+	for p := 0; p < r.numPlayers; p++ {
+		if r.IsPartner(p, r.maker) && r.hands[p].Size() < r.rules.HandSize() {
+			return true
+		}
+	}
+	return false
+}
+
+// cardPower ranks a card for passing to a lone partner: any trump beats any
+// card of another suit.
+func cardPower(c Card, trump Suit) int {
+	if c.IsTrump(trump) {
+		return 100 + c.TrumpValue(trump)
+	}
+	return c.OffSuitValue()
+}
+
 // beginPostTrump runs after trump is finalized (round-2 call, or round-1 order-up
 // once the dealer has discarded). If the maker is going alone and the
 // defend-alone rule is on, it opens the defend-alone declaration window
@@ -448,7 +764,7 @@ func (r *Round) pollOrder() []int {
 // team, not already sitting out) in poll order, or -1 if none.
 func (r *Round) firstDefenderToPoll() int {
 	for _, p := range r.pollOrder() {
-		if Team(p) != r.makerTeam && !r.isSittingOut(p) {
+		if r.Team(p) != r.makerTeam && !r.isSittingOut(p) {
 			return p
 		}
 	}
@@ -462,7 +778,7 @@ func (r *Round) nextDefenderToPoll(from int) int {
 	order := r.pollOrder()
 	seen := false
 	for _, p := range order {
-		if seen && Team(p) != r.makerTeam && !r.isSittingOut(p) {
+		if seen && r.Team(p) != r.makerTeam && !r.isSittingOut(p) {
 			return p
 		}
 		if p == from {
@@ -498,7 +814,7 @@ func (r *Round) handleDefendAlone(action DefendAloneAction) error {
 	if r.aloneDefender >= 0 {
 		return PlayError("a defender has already declared defend-alone")
 	}
-	if Team(action.PlayerIdx) == r.makerTeam {
+	if r.Team(action.PlayerIdx) == r.makerTeam {
 		return PlayError("only a defender may declare defend-alone")
 	}
 	if action.PlayerIdx != r.defendAlonePoll {
@@ -515,17 +831,24 @@ func (r *Round) handleDiscard(action DiscardAction) error {
 	if r.phase != PhaseDiscard {
 		return PlayError("not in discard phase")
 	}
-	if action.PlayerIdx != r.dealer {
-		return PlayError("only dealer can discard")
+	discarder := r.discarder()
+	if action.PlayerIdx != discarder {
+		if discarder == r.dealer {
+			return PlayError("only dealer can discard")
+		}
+		return PlayError("only the player going alone can discard")
 	}
-	if !r.hands[r.dealer].Contains(action.Card) {
+	if !r.hands[discarder].Contains(action.Card) {
 		return ErrCardNotInHand
 	}
+	if r.stackUnder(discarder, action.Card) >= 0 {
+		return PlayError("a face-up stack card cannot be discarded")
+	}
 
 	// Verify the removal actually works
-	beforeSize := r.hands[r.dealer].Size()
-	removed := r.hands[r.dealer].Remove(action.Card)
-	afterSize := r.hands[r.dealer].Size()
+	beforeSize := r.hands[discarder].Size()
+	removed := r.hands[discarder].Remove(action.Card)
+	afterSize := r.hands[discarder].Size()
 
 	if !removed {
 		return PlayError("failed to remove card from hand")
@@ -533,19 +856,37 @@ func (r *Round) handleDiscard(action DiscardAction) error {
 	if afterSize != beforeSize-1 {
 		return PlayError("hand size did not decrease after discard")
 	}
-	if afterSize != 5 {
-		return PlayError("dealer should have exactly 5 cards after discard")
+	// A lone maker given two cards discards twice
+	if afterSize > r.rules.HandSize() {
+		return nil
 	}
+	if err := r.checkHandSizes(); err != nil {
+		return err
+	}
+
+	r.beginExchange()
+
+	return nil
+}
+
+// discarder returns who discards in PhaseDiscard: a lone maker holding the
+// cards their partners passed, otherwise the dealer
+func (r *Round) discarder() int {
+	if r.maker >= 0 && r.hands[r.maker].Size() > r.rules.HandSize() {
+		return r.maker
+	}
+	return r.dealer
+}
 
-	// Sanity check: ALL players should have exactly 5 cards before play starts
+// checkHandSizes is the sanity check before play starts: every player in
+// the hand holds a full one. Partners sitting out have passed a card to a
+// lone maker under Rules.LonerExchange.
+func (r *Round) checkHandSizes() error {
 	for i := 0; i < r.numPlayers; i++ {
-		if r.hands[i].Size() != 5 {
-			return PlayError("all players must have 5 cards before play phase")
+		if r.hands[i].Size() != r.rules.HandSize() && !r.isSittingOut(i) {
+			return PlayError(fmt.Sprintf("all players must have %d cards before play phase", r.rules.HandSize()))
 		}
 	}
-
-	r.beginPostTrump()
-
 	return nil
 }
 
@@ -561,17 +902,21 @@ func (r *Round) handlePlayCard(action PlayCardAction) error {
 
 	hand := r.hands[action.PlayerIdx]
 
-	// Sanity check: no player should have more than 5 cards during play
-	if hand.Size() > 5 {
-		return PlayError("player has more than 5 cards - this is a bug")
+	// Sanity check: no player should hold more than a full hand during play
+	if hand.Size() > r.rules.HandSize() {
+		return PlayError("player has more than a full hand - this is a bug")
 	}
 
 	if err := ValidatePlay(hand, action.Card, r.currentTrick); err != nil {
 		return err
 	}
 
-	// Play the card
+	// Play the card, turning up the stack card beneath it
 	hand.Remove(action.Card)
+	if i := r.stackUnder(action.PlayerIdx, action.Card); i >= 0 {
+		hand.Add(r.stacks[action.PlayerIdx][i].Down)
+		r.stacks[action.PlayerIdx] = slices.Delete(r.stacks[action.PlayerIdx], i, i+1)
+	}
 	r.currentTrick.Play(action.PlayerIdx, action.Card)
 
 	// Check if trick is complete. Count active players (those not sitting out):
@@ -592,8 +937,8 @@ func (r *Round) completeTrick() {
 	}
 	r.trickHistory = append(r.trickHistory, result)
 
-	// Check if round is over (all 5 tricks played)
-	if len(r.trickHistory) >= 5 {
+	// Check if round is over (every trick played)
+	if len(r.trickHistory) >= r.rules.TrickCount() {
 		r.phase = PhaseRoundEnd
 		return
 	}
@@ -605,12 +950,13 @@ func (r *Round) completeTrick() {
 // Result returns the round result (only valid in PhaseRoundEnd)
 func (r *Round) Result() RoundResult {
 	makerTricks := r.TeamTricksWon(r.makerTeam)
+	tricks := r.rules.TrickCount()
 
 	result := RoundResult{
 		Makers:      r.makerTeam,
 		MakerTricks: makerTricks,
 		WasAlone:    r.alone,
-		WasEuchred:  makerTricks < 3,
+		WasEuchred:  makerTricks <= tricks/2,
 	}
 
 	if result.WasEuchred {
@@ -620,15 +966,15 @@ func (r *Round) Result() RoundResult {
 			result.WasDefendedAlone = true
 			result.DefendPoints = 4
 		}
-	} else if makerTricks == 5 {
-		// March (all 5 tricks)
+	} else if makerTricks == tricks {
+		// March (every trick)
 		if r.alone {
 			result.MakerPoints = 4
 		} else {
 			result.MakerPoints = 2
 		}
 	} else {
-		// Made it (3 or 4 tricks)
+		// Made it (a majority of the tricks)
 		result.MakerPoints = 1
 	}
 
@@ -659,24 +1005,29 @@ func (r *Round) LegalActions() []Action {
 	case PhaseBidRound1:
 		actions = append(actions, PassAction{PlayerIdx: player})
 		actions = append(actions, OrderUpAction{PlayerIdx: player, Alone: false})
-		actions = append(actions, OrderUpAction{PlayerIdx: player, Alone: true})
+		if r.HasPartner(player) {
+			actions = append(actions, OrderUpAction{PlayerIdx: player, Alone: true})
+		}
 
 	case PhaseBidRound2:
-		// Under stick-the-dealer the dealer cannot pass; they must name a suit.
-		if !r.rules.StickTheDealer || player != r.dealer {
+		// Under stick-the-dealer, or with the Benny turned up, the dealer
+		// cannot pass; they must name a suit.
+		if !r.MustCall() {
 			actions = append(actions, PassAction{PlayerIdx: player})
 		}
 		// Can call any suit except the turned card's suit
 		for _, suit := range []Suit{Clubs, Diamonds, Hearts, Spades} {
 			if suit != r.turnedCard.Suit {
 				actions = append(actions, CallTrumpAction{PlayerIdx: player, Suit: suit, Alone: false})
-				actions = append(actions, CallTrumpAction{PlayerIdx: player, Suit: suit, Alone: true})
+				if r.HasPartner(player) {
+					actions = append(actions, CallTrumpAction{PlayerIdx: player, Suit: suit, Alone: true})
+				}
 			}
 		}
 
 	case PhaseDiscard:
-		// Can discard any card in hand
-		for _, card := range r.hands[r.dealer].Cards() {
+		// Can discard any card in hand but a face-up stack card
+		for _, card := range r.Discardable() {
 			actions = append(actions, DiscardAction{PlayerIdx: player, Card: card})
 		}
 
@@ -700,9 +1051,44 @@ func (r *Round) BidRound() int {
 	return r.bidRound
 }
 
+// ActionLog returns every action applied this round, in order: bids,
+// discards, defend-alone decisions and card plays.
+func (r *Round) ActionLog() []ActionRecord {
+	result := make([]ActionRecord, len(r.actionLog))
+	copy(result, r.actionLog)
+	return result
+}
+
+// History returns the complete record of the round so far: the deal, the
+// turned card and the action log.
+func (r *Round) History() HandHistory {
+	return HandHistory{
+		NumPlayers: r.numPlayers,
+		Dealer:     r.dealer,
+		Rules:      r.rules,
+		Deal:       copyDeal(r.deal),
+		Stacks:     copyStacks(r.dealtStacks),
+		TurnedCard: r.turnedCard,
+		Pack:       r.packRecord(),
+		Actions:    r.ActionLog(),
+	}
+}
+
 // TrickHistory returns all completed tricks
 func (r *Round) TrickHistory() []TrickResult {
 	result := make([]TrickResult, len(r.trickHistory))
 	copy(result, r.trickHistory)
 	return result
 }
+
+// copyStacks returns an independent copy of per-seat stacks.
+func copyStacks(stacks [][]Stack) [][]Stack {
+	if stacks == nil {
+		return nil
+	}
+	out := make([][]Stack, len(stacks))
+	for i, row := range stacks {
+		out[i] = append([]Stack(nil), row...)
+	}
+	return out
+}
@@ -1,6 +1,9 @@
 package engine
 
-import "testing"
+import (
+	"slices"
+	"testing"
+)
 
 func TestNewRound(t *testing.T) {
 	round := NewRound(4, 0)
@@ -413,3 +416,218 @@ func TestRoundResult(t *testing.T) {
 		t.Error("Total tricks should be 5")
 	}
 }
+
+// TestRoundTurnedBennyGoesToTheDealer verifies that a turned-up joker skips
+// ordering up: the dealer must name trump, then picks the Benny up and
+// discards, and the round's pack is the 25-card deck it was dealt from.
+func TestRoundTurnedBennyGoesToTheDealer(t *testing.T) {
+	hands := [][]Card{
+		{{Clubs, Nine}, {Clubs, Ten}, {Clubs, Jack}, {Clubs, Queen}, {Clubs, King}},
+		{{Diamonds, Nine}, {Diamonds, Ten}, {Diamonds, Jack}, {Diamonds, Queen}, {Diamonds, King}},
+		{{Hearts, Nine}, {Hearts, Ten}, {Hearts, Jack}, {Hearts, Queen}, {Hearts, King}},
+		{{Spades, Nine}, {Spades, Ten}, {Spades, Jack}, {Spades, Queen}, {Spades, King}},
+	}
+	benny := Card{NoSuit, Joker}
+	round, err := HandHistory{NumPlayers: 4, Dealer: 3, Rules: DefaultRules(), Deal: hands, TurnedCard: benny,
+		Pack: NewBritishDeck().Cards()}.Replay(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if round.Phase() != PhaseBidRound2 || round.CurrentPlayer() != 3 || !round.MustCall() {
+		t.Fatalf("a turned Benny should put the dealer on a forced call, got %s with seat %d to act",
+			round.Phase(), round.CurrentPlayer())
+	}
+	if err := round.ApplyAction(PassAction{PlayerIdx: 3}); err == nil {
+		t.Fatal("the dealer passed on a turned Benny")
+	}
+	for _, a := range round.LegalActions() {
+		if _, ok := a.(PassAction); ok {
+			t.Error("Pass is offered to a dealer who turned up the Benny")
+		}
+	}
+	if err := round.ApplyAction(CallTrumpAction{PlayerIdx: 3, Suit: Spades}); err != nil {
+		t.Fatalf("calling spades: %v", err)
+	}
+	if round.Phase() != PhaseDiscard || !slices.Contains(round.Hand(3), benny) {
+		t.Fatalf("the dealer should pick up the Benny and discard, got %s", round.Phase())
+	}
+	if err := round.ApplyAction(DiscardAction{PlayerIdx: 3, Card: Card{Spades, Nine}}); err != nil {
+		t.Fatalf("discard: %v", err)
+	}
+	if round.Phase() != PhasePlay {
+		t.Errorf("play should start after the discard, got %s", round.Phase())
+	}
+	if got := len(round.Pack()); got != 25 {
+		t.Errorf("pack has %d cards, want 25", got)
+	}
+	if got := len(round.History().Pack); got != 25 {
+		t.Errorf("history kept %d pack cards, want 25", got)
+	}
+}
+
+// TestRoundStacksTurnUpWhenUncovered plays a two-handed round with stacks:
+// face-up stack cards are played from the hand, each one turns up the card
+// beneath it, they can't be discarded, and the round runs to 11 tricks.
+func TestRoundStacksTurnUpWhenUncovered(t *testing.T) {
+	rules := Rules{AllowMisdeal: true, Stacks: 3}
+	round := NewRoundWithRules(2, 0, rules)
+	deck := NewStandardDeck()
+	deck.Seed(5)
+	round.Deal(deck)
+
+	for p := 0; p < 2; p++ {
+		stacks := round.Stacks(p)
+		if len(stacks) != 3 || len(round.Hand(p)) != 8 || round.CardsLeft(p) != 11 {
+			t.Fatalf("player %d dealt %d stacks and %d cards in hand, want 3 and 8", p, len(stacks), len(round.Hand(p)))
+		}
+		for _, st := range stacks {
+			if !slices.Contains(round.Hand(p), st.Up) || slices.Contains(round.Hand(p), st.Down) {
+				t.Fatalf("stack %v: the face-up card should be in hand and the face-down one not", st)
+			}
+		}
+	}
+
+	// Player 1 orders up, so the dealer picks up and must keep the face-up
+	// stack cards on the table.
+	if err := round.ApplyAction(OrderUpAction{PlayerIdx: 1}); err != nil {
+		t.Fatalf("order up: %v", err)
+	}
+	up := round.Stacks(0)[0].Up
+	if err := round.ApplyAction(DiscardAction{PlayerIdx: 0, Card: up}); err == nil {
+		t.Fatal("discarding a face-up stack card should be rejected")
+	}
+	for _, a := range round.LegalActions() {
+		if a.(DiscardAction).Card == up {
+			t.Fatal("a face-up stack card is offered as a discard")
+		}
+	}
+	if err := round.ApplyAction(round.LegalActions()[0]); err != nil {
+		t.Fatalf("discard: %v", err)
+	}
+
+	restored, err := RestoreRound(round.Snapshot())
+	if err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if !slices.Equal(restored.Stacks(0), round.Stacks(0)) || !slices.Equal(restored.Stacks(1), round.Stacks(1)) {
+		t.Fatal("a restored snapshot lost the stacks")
+	}
+
+	revealed := false
+	for !round.IsComplete() {
+		p := round.CurrentPlayer()
+		stacks := round.Stacks(p)
+		action := round.LegalActions()[0].(PlayCardAction)
+		for _, a := range round.LegalActions() {
+			// Prefer uncovering a stack to see the card beneath turn up.
+			if len(stacks) > 0 && a.(PlayCardAction).Card == stacks[0].Up {
+				action = a.(PlayCardAction)
+			}
+		}
+		if err := round.ApplyAction(action); err != nil {
+			t.Fatalf("play %v: %v", action.Card, err)
+		}
+		if len(stacks) > 0 && action.Card == stacks[0].Up {
+			if !slices.Contains(round.Hand(p), stacks[0].Down) || len(round.Stacks(p)) != len(stacks)-1 {
+				t.Fatalf("playing %v did not turn up %v", stacks[0].Up, stacks[0].Down)
+			}
+			revealed = true
+		}
+	}
+	if !revealed {
+		t.Fatal("no stack card was ever played")
+	}
+	if got := len(round.TrickHistory()); got != 11 {
+		t.Errorf("round played %d tricks, want 11", got)
+	}
+	result := round.Result()
+	if result.WasEuchred != (result.MakerTricks < 6) {
+		t.Errorf("maker took %d of 11 tricks but euchred = %v", result.MakerTricks, result.WasEuchred)
+	}
+
+	replayed, err := round.History().Replay(-1)
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if replayed.Result() != result {
+		t.Errorf("replayed result %+v, want %+v", replayed.Result(), result)
+	}
+}
+
+// TestRoundLonerExchange verifies a lone maker under Rules.LonerExchange
+// takes each partner's best card, discards as many, and plays against the
+// defenders with the partners sitting out.
+func TestRoundLonerExchange(t *testing.T) {
+	rules := Rules{AllowMisdeal: true, Teams: 2, LonerExchange: true}
+	round := NewRoundWithRules(6, 5, rules)
+	deck := NewDeck36()
+	deck.Seed(3)
+	round.Deal(deck)
+
+	// Seat 0 calls alone in round 2; seats 2 and 4 are the partners.
+	for round.Phase() == PhaseBidRound1 {
+		if err := round.ApplyAction(PassAction{PlayerIdx: round.CurrentPlayer()}); err != nil {
+			t.Fatalf("pass: %v", err)
+		}
+	}
+	trump := Hearts
+	if round.TurnedCard().Suit == Hearts {
+		trump = Spades
+	}
+	best := make(map[int]Card)
+	for _, p := range []int{2, 4} {
+		hand := round.Hand(p)
+		best[p] = hand[0]
+		for _, c := range hand[1:] {
+			if cardPower(c, trump) > cardPower(best[p], trump) {
+				best[p] = c
+			}
+		}
+	}
+	if err := round.ApplyAction(CallTrumpAction{PlayerIdx: 0, Suit: trump, Alone: true}); err != nil {
+		t.Fatalf("call alone: %v", err)
+	}
+
+	if round.Phase() != PhaseDiscard || round.CurrentPlayer() != 0 || !round.Exchanged() {
+		t.Fatalf("after calling alone: phase %v, player %d to act; want the maker to discard", round.Phase(), round.CurrentPlayer())
+	}
+	for _, p := range []int{2, 4} {
+		if !slices.Contains(round.Hand(0), best[p]) || slices.Contains(round.Hand(p), best[p]) {
+			t.Errorf("partner %d did not pass their best card %v", p, best[p])
+		}
+	}
+	if err := round.ApplyAction(DiscardAction{PlayerIdx: 5, Card: round.Hand(5)[0]}); err == nil {
+		t.Error("the dealer discarded in the lone maker's place")
+	}
+	for i := 0; i < 2; i++ {
+		if round.Phase() != PhaseDiscard {
+			t.Fatalf("discard %d: phase is %v", i+1, round.Phase())
+		}
+		if err := round.ApplyAction(round.LegalActions()[0]); err != nil {
+			t.Fatalf("discard %d: %v", i+1, err)
+		}
+	}
+	if round.Phase() != PhasePlay || len(round.Hand(0)) != 5 {
+		t.Fatalf("after discarding: phase %v with %d cards, want play with 5", round.Phase(), len(round.Hand(0)))
+	}
+
+	for !round.IsComplete() {
+		if err := round.ApplyAction(round.LegalActions()[0]); err != nil {
+			t.Fatalf("play: %v", err)
+		}
+	}
+	for _, tr := range round.TrickHistory() {
+		if len(tr.Cards) != 4 {
+			t.Fatalf("a trick had %d cards, want the maker and three defenders", len(tr.Cards))
+		}
+	}
+
+	replayed, err := round.History().Replay(-1)
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if replayed.Result() != round.Result() {
+		t.Errorf("replay scored %+v, want %+v", replayed.Result(), round.Result())
+	}
+}
@@ -17,9 +17,47 @@ package engine
 // If both are false (a misconfiguration), the engine defensively falls back to a
 // misdeal so an all-pass round 2 cannot dead-end bidding (see Round.handlePass).
 type Rules struct {
-	StickTheDealer   bool // round 2: dealer may not pass; must call trump
-	AllowDefendAlone bool // defenders may go alone for 4 points on a euchre
-	AllowMisdeal     bool // if all pass round 2 (and not stick-the-dealer), re-deal with SAME dealer, no score
+	StickTheDealer   bool `json:"stickTheDealer"`   // round 2: dealer may not pass; must call trump
+	AllowDefendAlone bool `json:"allowDefendAlone"` // defenders may go alone for 4 points on a euchre
+	AllowMisdeal     bool `json:"allowMisdeal"`     // if all pass round 2 (and not stick-the-dealer), re-deal with SAME dealer, no score
+
+	// Teams is how many sides the seats are split into, seated alternately
+	// round the table: seat p plays for team p % Teams. Zero means the usual
+	// two partnerships; Cutthroat sets it to the player count so everyone
+	// plays for themselves.
+	Teams int `json:"teams,omitempty"`
+
+	// Stacks is how many face-down cards each player is dealt in a row in
+	// front of them, each covered by a face-up card, as in two-handed
+	// Euchre. The face-up cards are played from the hand like any other,
+	// and playing one turns up the card beneath it, so every stack adds two
+	// tricks to the round.
+	Stacks int `json:"stacks,omitempty"`
+
+	// LonerExchange has each partner of a maker going alone pass them their
+	// best card before play, as in six-handed Euchre. The maker then
+	// discards as many cards as they were given.
+	LonerExchange bool `json:"lonerExchange,omitempty"`
+}
+
+// TeamCount returns the number of teams the rules seat (2 unless Teams is set)
+func (r Rules) TeamCount() int {
+	if r.Teams <= 0 {
+		return 2
+	}
+	return r.Teams
+}
+
+// HandSize returns how many cards each player holds before play starts:
+// five, plus the face-up card of each stack
+func (r Rules) HandSize() int {
+	return 5 + r.Stacks
+}
+
+// TrickCount returns how many tricks a round plays: five, plus two for
+// each stack
+func (r Rules) TrickCount() int {
+	return 5 + 2*r.Stacks
 }
 
 // DefaultRules returns the standard rule configuration.
@@ -0,0 +1,308 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// SnapshotVersion is the current version of the game/round snapshot format.
+// Bump it whenever a field changes meaning; RestoreGame and RestoreRound
+// reject snapshots from a version they do not understand.
+const SnapshotVersion = 1
+
+// GameSnapshot is a plain, serializable copy of a Game's complete state. It is
+// what Game.MarshalJSON writes, so a saved game, a bug-report attachment and
+// a mid-hand analysis position all share one format.
+type GameSnapshot struct {
+	Version      int            `json:"version"`
+	NumPlayers   int            `json:"numPlayers"`
+	TargetScore  int            `json:"targetScore"`
+	Deck         string         `json:"deck"`
+	Rules        Rules          `json:"rules"`
+	Seed         int64          `json:"seed,omitempty"`
+	Deals        int            `json:"deals,omitempty"` // rounds dealt so far; replays the seeded RNG on restore
+	Board        *Board         `json:"board,omitempty"` // the current round's deal
+	Scores       []int          `json:"scores"`
+	Dealer       int            `json:"dealer"`
+	Round        *RoundSnapshot `json:"round,omitempty"`
+	RoundHistory []RoundResult  `json:"roundHistory"`
+	Hands        []HandHistory  `json:"hands,omitempty"` // every finished round, misdeals included
+	Undos        int            `json:"undos,omitempty"` // takebacks made during the game
+}
+
+// RoundSnapshot is a plain, serializable copy of a Round's complete state.
+type RoundSnapshot struct {
+	Version         int            `json:"version"`
+	NumPlayers      int            `json:"numPlayers"`
+	Dealer          int            `json:"dealer"`
+	Rules           Rules          `json:"rules"`
+	Phase           GamePhase      `json:"phase"`
+	Misdeal         bool           `json:"misdeal,omitempty"`
+	Trump           Suit           `json:"trump"`
+	TurnedCard      Card           `json:"turnedCard"`
+	Maker           int            `json:"maker"`
+	MakerTeam       int            `json:"makerTeam"`
+	Alone           bool           `json:"alone,omitempty"`
+	AloneDefender   int            `json:"aloneDefender"`
+	DefendAlonePoll int            `json:"defendAlonePoll"`
+	BidRound        int            `json:"bidRound"`
+	CurrentBidder   int            `json:"currentBidder"`
+	Hands           [][]Card       `json:"hands"`
+	Stacks          [][]Stack      `json:"stacks,omitempty"`
+	CurrentTrick    *TrickSnapshot `json:"currentTrick,omitempty"`
+	TricksWon       []int          `json:"tricksWon"`
+	TrickHistory    []TrickResult  `json:"trickHistory"`
+	Deal            [][]Card       `json:"deal,omitempty"`
+	DealtStacks     [][]Stack      `json:"dealtStacks,omitempty"`
+	Pack            []Card         `json:"pack,omitempty"` // the deck dealt from; omitted means the standard 24 cards
+	ActionLog       []ActionRecord `json:"actionLog,omitempty"`
+}
+
+// TrickSnapshot is a plain, serializable copy of a Trick in progress.
+type TrickSnapshot struct {
+	Cards    []PlayedCard `json:"cards"`
+	LeadSuit Suit         `json:"leadSuit"`
+	Trump    Suit         `json:"trump"`
+}
+
+// deckConfigNames maps the deck configurations a snapshot can name back to
+// their constructors.
+var deckConfigNames = map[string]func() DeckConfig{
+	"standard": func() DeckConfig { return StandardDeckConfig{} },
+	"british":  func() DeckConfig { return BritishDeckConfig{} },
+	"deck32":   func() DeckConfig { return Deck32Config{} },
+	"deck36":   func() DeckConfig { return Deck36Config{} },
+}
+
+// deckConfigName returns the snapshot name of a deck configuration.
+func deckConfigName(c DeckConfig) (string, error) {
+	switch c.(type) {
+	case StandardDeckConfig, *StandardDeckConfig:
+		return "standard", nil
+	case BritishDeckConfig, *BritishDeckConfig:
+		return "british", nil
+	case Deck32Config, *Deck32Config:
+		return "deck32", nil
+	case Deck36Config, *Deck36Config:
+		return "deck36", nil
+	default:
+		return "", fmt.Errorf("deck config %T cannot be snapshotted", c)
+	}
+}
+
+// Snapshot returns a deep copy of the game's state. Decks are named rather
+// than stored, so snapshotting a game built on a custom DeckConfig fails.
+func (g *Game) Snapshot() (GameSnapshot, error) {
+	deck, err := deckConfigName(g.deckConfig)
+	if err != nil {
+		return GameSnapshot{}, err
+	}
+	s := GameSnapshot{
+		Version:      SnapshotVersion,
+		NumPlayers:   g.numPlayers,
+		TargetScore:  g.targetScore,
+		Deck:         deck,
+		Rules:        g.rules,
+		Seed:         g.seed,
+		Deals:        g.deals,
+		Scores:       g.Scores(),
+		Dealer:       g.dealer,
+		RoundHistory: g.RoundHistory(),
+		Hands:        g.HandHistories(),
+		Undos:        g.undos,
+	}
+	if g.currentRound != nil {
+		rs := g.currentRound.Snapshot()
+		s.Round = &rs
+		board := g.board
+		s.Board = &board
+	}
+	return s, nil
+}
+
+// RestoreGame rebuilds a Game from a snapshot. A seeded game resumes its deal
+// sequence exactly where it left off.
+func RestoreGame(s GameSnapshot) (*Game, error) {
+	if s.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported game snapshot version %d (want %d)", s.Version, SnapshotVersion)
+	}
+	newDeck, ok := deckConfigNames[s.Deck]
+	if !ok {
+		return nil, fmt.Errorf("unknown deck %q in game snapshot", s.Deck)
+	}
+	if s.NumPlayers <= 0 {
+		return nil, fmt.Errorf("invalid player count %d in game snapshot", s.NumPlayers)
+	}
+	if s.Dealer < 0 || s.Dealer >= s.NumPlayers {
+		return nil, fmt.Errorf("invalid dealer %d in game snapshot", s.Dealer)
+	}
+
+	g := NewGame(GameConfig{
+		NumPlayers:  s.NumPlayers,
+		TargetScore: s.TargetScore,
+		DeckConfig:  newDeck(),
+		Rules:       s.Rules,
+		Seed:        s.Seed,
+	})
+	if len(s.Scores) != len(g.scores) {
+		return nil, fmt.Errorf("game snapshot has %d scores, want %d", len(s.Scores), len(g.scores))
+	}
+	copy(g.scores, s.Scores)
+	g.dealer = s.Dealer
+	g.roundHistory = append(g.roundHistory, s.RoundHistory...)
+	g.handHistory = append(g.handHistory, s.Hands...)
+	g.undos = s.Undos
+
+	// Replay the seeded RNG so the next deal is the one the original game
+	// would have made.
+	g.rng = seededRNG(g.seed, s.Deals)
+	g.deals = s.Deals
+	if s.Board != nil {
+		g.board = *s.Board
+	}
+
+	if s.Round != nil {
+		r, err := RestoreRound(*s.Round)
+		if err != nil {
+			return nil, err
+		}
+		g.currentRound = r
+	}
+	return g, nil
+}
+
+// MarshalJSON encodes the game as a versioned GameSnapshot.
+func (g *Game) MarshalJSON() ([]byte, error) {
+	s, err := g.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON replaces the game's state with a decoded GameSnapshot.
+func (g *Game) UnmarshalJSON(data []byte) error {
+	var s GameSnapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	restored, err := RestoreGame(s)
+	if err != nil {
+		return err
+	}
+	*g = *restored
+	return nil
+}
+
+// Snapshot returns a deep copy of the round's state.
+func (r *Round) Snapshot() RoundSnapshot {
+	s := RoundSnapshot{
+		Version:         SnapshotVersion,
+		NumPlayers:      r.numPlayers,
+		Dealer:          r.dealer,
+		Rules:           r.rules,
+		Phase:           r.phase,
+		Misdeal:         r.misdeal,
+		Trump:           r.trump,
+		TurnedCard:      r.turnedCard,
+		Maker:           r.maker,
+		MakerTeam:       r.makerTeam,
+		Alone:           r.alone,
+		AloneDefender:   r.aloneDefender,
+		DefendAlonePoll: r.defendAlonePoll,
+		BidRound:        r.bidRound,
+		CurrentBidder:   r.currentBidder,
+		Hands:           make([][]Card, len(r.hands)),
+		Stacks:          copyStacks(r.stacks),
+		TricksWon:       make([]int, len(r.tricksWon)),
+		TrickHistory:    r.TrickHistory(),
+		Deal:            copyDeal(r.deal),
+		DealtStacks:     copyStacks(r.dealtStacks),
+		Pack:            r.packRecord(),
+		ActionLog:       r.ActionLog(),
+	}
+	for i, h := range r.hands {
+		s.Hands[i] = h.Cards()
+	}
+	copy(s.TricksWon, r.tricksWon)
+	for i := range s.TrickHistory {
+		s.TrickHistory[i].Cards = append([]PlayedCard(nil), s.TrickHistory[i].Cards...)
+	}
+	if r.currentTrick != nil {
+		s.CurrentTrick = &TrickSnapshot{
+			Cards:    r.currentTrick.Cards(),
+			LeadSuit: r.currentTrick.leadSuit,
+			Trump:    r.currentTrick.trump,
+		}
+	}
+	return s
+}
+
+// RestoreRound rebuilds a Round from a snapshot.
+func RestoreRound(s RoundSnapshot) (*Round, error) {
+	if s.Version != SnapshotVersion {
+		return nil, fmt.Errorf("unsupported round snapshot version %d (want %d)", s.Version, SnapshotVersion)
+	}
+	if s.NumPlayers <= 0 || len(s.Hands) != s.NumPlayers || len(s.TricksWon) != s.NumPlayers {
+		return nil, fmt.Errorf("round snapshot for %d players has %d hands and %d trick counts",
+			s.NumPlayers, len(s.Hands), len(s.TricksWon))
+	}
+	if s.Dealer < 0 || s.Dealer >= s.NumPlayers {
+		return nil, fmt.Errorf("invalid dealer %d in round snapshot", s.Dealer)
+	}
+	if s.Phase == PhasePlay && s.CurrentTrick == nil {
+		return nil, PlayError("round snapshot is in play with no current trick")
+	}
+
+	r := NewRoundWithRules(s.NumPlayers, s.Dealer, s.Rules)
+	r.phase = s.Phase
+	r.misdeal = s.Misdeal
+	r.trump = s.Trump
+	r.turnedCard = s.TurnedCard
+	r.maker = s.Maker
+	r.makerTeam = s.MakerTeam
+	r.alone = s.Alone
+	r.aloneDefender = s.AloneDefender
+	r.defendAlonePoll = s.DefendAlonePoll
+	r.bidRound = s.BidRound
+	r.currentBidder = s.CurrentBidder
+	for i, cards := range s.Hands {
+		r.hands[i] = NewHandWith(cards)
+	}
+	r.stacks = copyStacks(s.Stacks)
+	copy(r.tricksWon, s.TricksWon)
+	r.deal = copyDeal(s.Deal)
+	r.dealtStacks = copyStacks(s.DealtStacks)
+	r.pack = append([]Card(nil), s.Pack...)
+	r.actionLog = append(r.actionLog, s.ActionLog...)
+	for _, tr := range s.TrickHistory {
+		tr.Cards = append([]PlayedCard(nil), tr.Cards...)
+		r.trickHistory = append(r.trickHistory, tr)
+	}
+	if s.CurrentTrick != nil {
+		t := NewTrick(s.CurrentTrick.Trump)
+		t.cards = append(t.cards, s.CurrentTrick.Cards...)
+		t.leadSuit = s.CurrentTrick.LeadSuit
+		r.currentTrick = t
+	}
+	return r, nil
+}
+
+// MarshalJSON encodes the round as a versioned RoundSnapshot.
+func (r *Round) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.Snapshot())
+}
+
+// UnmarshalJSON replaces the round's state with a decoded RoundSnapshot.
+func (r *Round) UnmarshalJSON(data []byte) error {
+	var s RoundSnapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	restored, err := RestoreRound(s)
+	if err != nil {
+		return err
+	}
+	*r = *restored
+	return nil
+}
@@ -0,0 +1,138 @@
+package engine
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// midTrickGame returns a seeded game that has finished one round and is part
+// way through the first trick of the next.
+func midTrickGame(t *testing.T) *Game {
+	t.Helper()
+	config := DefaultGameConfig()
+	config.Seed = 77
+	game := NewGame(config)
+
+	game.StartRound()
+	playFirstLegal(t, game)
+
+	game.StartRound()
+	for game.Phase() != PhasePlay || len(game.Round().CurrentTrick()) < 2 {
+		if err := game.ApplyAction(firstNonPassAction(game.LegalActions())); err != nil {
+			t.Fatalf("apply failed: %v", err)
+		}
+	}
+	return game
+}
+
+func TestGameJSONRoundTrip(t *testing.T) {
+	game := midTrickGame(t)
+
+	data, err := json.Marshal(game)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	var restored Game
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+
+	want, _ := game.Snapshot()
+	got, err := restored.Snapshot()
+	if err != nil {
+		t.Fatalf("snapshot of restored game failed: %v", err)
+	}
+	if !reflect.DeepEqual(want, got) {
+		t.Fatalf("restored game differs:\nwant %+v\ngot  %+v", want, got)
+	}
+}
+
+func TestRestoredGamePlaysOnIdentically(t *testing.T) {
+	game := midTrickGame(t)
+	s, err := game.Snapshot()
+	if err != nil {
+		t.Fatalf("snapshot failed: %v", err)
+	}
+	restored, err := RestoreGame(s)
+	if err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+
+	// Finish the current round and deal the next one in both games: the
+	// partially played trick must complete the same way and the seeded RNG
+	// must produce the same next deal.
+	playFirstLegal(t, game)
+	playFirstLegal(t, restored)
+	if !reflect.DeepEqual(game.Scores(), restored.Scores()) {
+		t.Fatalf("scores diverged: %v vs %v", game.Scores(), restored.Scores())
+	}
+	if game.IsOver() {
+		return
+	}
+	game.StartRound()
+	restored.StartRound()
+	for p := 0; p < game.NumPlayers(); p++ {
+		if !reflect.DeepEqual(game.Hand(p), restored.Hand(p)) {
+			t.Fatalf("seat %d next deal diverged: %v vs %v", p, game.Hand(p), restored.Hand(p))
+		}
+	}
+}
+
+func TestRoundJSONRoundTripPreservesDefendAloneWindow(t *testing.T) {
+	round := NewRoundWithRules(4, 0, Rules{AllowDefendAlone: true, AllowMisdeal: true})
+	deck := NewStandardDeck()
+	deck.Seed(3)
+	round.Deal(deck)
+
+	if err := round.ApplyAction(OrderUpAction{PlayerIdx: 1, Alone: true}); err != nil {
+		t.Fatalf("order up failed: %v", err)
+	}
+	if err := round.ApplyAction(DiscardAction{PlayerIdx: 0, Card: round.Hand(0)[0]}); err != nil {
+		t.Fatalf("discard failed: %v", err)
+	}
+	if round.Phase() != PhaseDefendAlone {
+		t.Fatalf("expected the defend-alone window, got %s", round.Phase())
+	}
+
+	data, err := json.Marshal(round)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	var restored Round
+	if err := json.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+
+	if restored.Phase() != PhaseDefendAlone || restored.CurrentPlayer() != round.CurrentPlayer() {
+		t.Fatalf("restored round is in %s polling %d, want %s polling %d",
+			restored.Phase(), restored.CurrentPlayer(), round.Phase(), round.CurrentPlayer())
+	}
+	if !reflect.DeepEqual(round.LegalActions(), restored.LegalActions()) {
+		t.Errorf("legal actions diverged: %v vs %v", round.LegalActions(), restored.LegalActions())
+	}
+}
+
+func TestSnapshotIsReadable(t *testing.T) {
+	data, err := json.Marshal(midTrickGame(t))
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	for _, want := range []string{`"version":1`, `"phase":"Play"`, `"suit":"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("snapshot JSON should contain %s", want)
+		}
+	}
+}
+
+func TestRestoreRejectsUnknownVersion(t *testing.T) {
+	s, err := midTrickGame(t).Snapshot()
+	if err != nil {
+		t.Fatalf("snapshot failed: %v", err)
+	}
+	s.Version = SnapshotVersion + 1
+	if _, err := RestoreGame(s); err == nil {
+		t.Error("restoring a snapshot from a newer version should fail")
+	}
+}
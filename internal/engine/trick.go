@@ -26,6 +26,14 @@ func (t *Trick) Play(player int, card Card) {
 	}
 }
 
+// Clone returns an independent copy of the trick
+func (t *Trick) Clone() *Trick {
+	c := *t
+	c.cards = make([]PlayedCard, len(t.cards), max(cap(t.cards), 4))
+	copy(c.cards, t.cards)
+	return &c
+}
+
 // Cards returns the played cards in order
 func (t *Trick) Cards() []PlayedCard {
 	result := make([]PlayedCard, len(t.cards))
@@ -146,11 +154,21 @@ func (t *Trick) Clear() {
 
 // TrickResult contains the outcome of a completed trick
 type TrickResult struct {
-	Winner    int
-	Cards     []PlayedCard
-	LeadSuit  Suit
-	Trump     Suit
-	WasTrumped bool
+	Winner     int          `json:"winner"`
+	Cards      []PlayedCard `json:"cards"`
+	LeadSuit   Suit         `json:"leadSuit"`
+	Trump      Suit         `json:"trump"`
+	WasTrumped bool         `json:"wasTrumped,omitempty"`
+}
+
+// copyTrickResults returns an independent copy of a list of trick results.
+func copyTrickResults(results []TrickResult) []TrickResult {
+	out := make([]TrickResult, len(results), max(cap(results), 5))
+	for i, res := range results {
+		res.Cards = append([]PlayedCard(nil), res.Cards...)
+		out[i] = res
+	}
+	return out
 }
 
 // Result returns the trick result after the trick is complete
@@ -142,6 +142,29 @@ func TestTrickWinner_LeftBowerAsLead(t *testing.T) {
 	}
 }
 
+func TestTrickWinner_BennyBeatsRightBower(t *testing.T) {
+	trick := NewTrick(Hearts)
+
+	// The Benny led counts as trump, so the others must follow with hearts
+	trick.Play(0, Card{NoSuit, Joker})
+	trick.Play(1, Card{Hearts, Jack}) // Right bower
+	trick.Play(2, Card{Diamonds, Jack})
+	trick.Play(3, Card{Hearts, Ace})
+
+	if winner := trick.Winner(); winner != 0 {
+		t.Errorf("Player 0 (the Benny) should win, got player %d", winner)
+	}
+	if trick.LeadSuit() != Hearts {
+		t.Errorf("Lead suit should be Hearts (the Benny), got %s", trick.LeadSuit())
+	}
+
+	// Holding only the Benny in trump, a player must follow a trump lead with it
+	hand := NewHandWith([]Card{{NoSuit, Joker}, {Spades, Ace}})
+	if err := ValidatePlay(hand, Card{Spades, Ace}, trick); err != ErrMustFollowSuit {
+		t.Errorf("discarding with the Benny in hand on a trump lead: got %v, want ErrMustFollowSuit", err)
+	}
+}
+
 func TestTrickIsComplete(t *testing.T) {
 	trick := NewTrick(Hearts)
 
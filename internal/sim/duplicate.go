@@ -0,0 +1,120 @@
+package sim
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// DuplicateConfig describes a duplicate match: every board is played at two
+// tables, and the side holding team 0's cards at one table holds team 1's at
+// the other.
+type DuplicateConfig struct {
+	Boards int               // number of boards
+	Seed   int64             // master seed; 0 picks a time-based seed
+	Game   engine.GameConfig // rules and deck for every board
+}
+
+// BoardResult is one board played at both tables. Points are indexed by
+// table, then side.
+type BoardResult struct {
+	Board  engine.Board
+	Points [2][2]int
+}
+
+// Net returns how many more points side 0 scored on the board than side 1,
+// over both tables. With the cards the same, this is the skill difference.
+func (b BoardResult) Net() int {
+	return b.Points[0][0] + b.Points[1][0] - b.Points[0][1] - b.Points[1][1]
+}
+
+// DuplicateResults is the board-by-board outcome of a duplicate match
+type DuplicateResults struct {
+	Seed   int64 // master seed actually used
+	Boards []BoardResult
+}
+
+// Net returns side 0's total net points over every board
+func (r DuplicateResults) Net() int {
+	net := 0
+	for _, b := range r.Boards {
+		net += b.Net()
+	}
+	return net
+}
+
+// Record counts the boards side 0 won, tied and lost on net points
+func (r DuplicateResults) Record() (won, tied, lost int) {
+	for _, b := range r.Boards {
+		switch net := b.Net(); {
+		case net > 0:
+			won++
+		case net < 0:
+			lost++
+		default:
+			tied++
+		}
+	}
+	return won, tied, lost
+}
+
+// RunDuplicate plays cfg.Boards boards between two sides, each given as
+// players for every seat (indexed by seat). At table 0 side 0 sits in team
+// 0's seats; at table 1 the sides swap.
+func RunDuplicate(cfg DuplicateConfig, sides [2][]ai.Player) (DuplicateResults, error) {
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	numPlayers := cfg.Game.NumPlayers
+	if numPlayers == 0 {
+		numPlayers = engine.DefaultGameConfig().NumPlayers
+	}
+	res := DuplicateResults{Seed: seed}
+	if teams := cfg.Game.Rules.TeamCount(); teams != 2 {
+		return res, fmt.Errorf("duplicate needs two teams to swap cards between, not %d", teams)
+	}
+
+	for _, board := range engine.DuplicateBoards(seed, cfg.Boards, numPlayers) {
+		br := BoardResult{Board: board}
+		for table := 0; table < 2; table++ {
+			players := make([]ai.Player, numPlayers)
+			for i := range players {
+				side := engine.Team(i)
+				if table == 1 {
+					side = 1 - side
+				}
+				players[i] = sides[side][i]
+			}
+			result, err := PlayBoard(cfg.Game, board, players)
+			if err != nil {
+				return res, fmt.Errorf("board %d, table %d: %w", board.Number, table+1, err)
+			}
+			for team := 0; team < 2; team++ {
+				side := team
+				if table == 1 {
+					side = 1 - team
+				}
+				br.Points[table][side] = result.Points(team)
+			}
+		}
+		res.Boards = append(res.Boards, br)
+	}
+	return res, nil
+}
+
+// PlayBoard deals a board and plays its one hand with the given players
+// (indexed by seat). A thrown-in hand scores nothing for either team.
+func PlayBoard(cfg engine.GameConfig, board engine.Board, players []ai.Player) (engine.RoundResult, error) {
+	cfg.Seed = 0
+	game := engine.NewGame(cfg)
+	game.StartBoard(board)
+	for !game.IsOver() && !game.NeedsNewRound() {
+		if err := Step(game, players); err != nil {
+			return engine.RoundResult{}, err
+		}
+	}
+	return game.Round().Result(), nil
+}
@@ -0,0 +1,41 @@
+package sim
+
+import (
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+func TestDuplicateMirrorMatchTiesEveryBoard(t *testing.T) {
+	// Medium plays deterministically, so the same cards in the same seats
+	// must produce the same result at both tables.
+	cfg := DuplicateConfig{Boards: 50, Seed: 5, Game: engine.DefaultGameConfig()}
+	res, err := RunDuplicate(cfg, [2][]ai.Player{allAI(ai.DifficultyMedium), allAI(ai.DifficultyMedium)})
+	if err != nil {
+		t.Fatalf("RunDuplicate failed: %v", err)
+	}
+	if len(res.Boards) != 50 {
+		t.Fatalf("played %d boards, want 50", len(res.Boards))
+	}
+	for _, b := range res.Boards {
+		if b.Net() != 0 {
+			t.Errorf("board %d: net %d between identical sides, points %v", b.Board.Number, b.Net(), b.Points)
+		}
+	}
+}
+
+func TestHardOutscoresEasyAtDuplicate(t *testing.T) {
+	cfg := DuplicateConfig{Boards: 300, Seed: 8, Game: engine.DefaultGameConfig()}
+	res, err := RunDuplicate(cfg, [2][]ai.Player{allAI(ai.DifficultyHard), allAI(ai.DifficultyEasy)})
+	if err != nil {
+		t.Fatalf("RunDuplicate failed: %v", err)
+	}
+	won, tied, lost := res.Record()
+	if won+tied+lost != 300 {
+		t.Fatalf("record %d-%d-%d doesn't cover 300 boards", won, tied, lost)
+	}
+	if res.Net() <= 0 || won <= lost {
+		t.Errorf("Hard should outscore Easy on the same cards: net %+d, boards %d-%d-%d", res.Net(), won, tied, lost)
+	}
+}
@@ -0,0 +1,98 @@
+package sim
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// explainer writes a game as it is played: each deal, each decision with the
+// reasons of players that can give them, and each hand's result. Its methods
+// do nothing on a nil explainer.
+type explainer struct {
+	w    io.Writer
+	game int // 1-based game number
+}
+
+// deal writes the hands just dealt
+func (e *explainer) deal(game *engine.Game, players []ai.Player) {
+	if e == nil {
+		return
+	}
+	fmt.Fprintf(e.w, "Game %d, deal %d: %s deals, %s turned up\n", e.game, game.Board().Number, players[game.Dealer()].Name(), game.TurnedCard())
+	for seat, p := range players {
+		cards := make([]string, 0, len(game.Hand(seat)))
+		for _, c := range game.Hand(seat) {
+			cards = append(cards, c.String())
+		}
+		fmt.Fprintf(e.w, "  %-8s %s\n", p.Name(), strings.Join(cards, " "))
+	}
+}
+
+// decision writes the action player chose and, if it can explain itself, why
+func (e *explainer) decision(player ai.Player, action engine.Action) {
+	if e == nil {
+		return
+	}
+	what := describeAction(action)
+	if x, ok := player.(ai.Explainer); ok {
+		if why, ok := x.Explain(); ok {
+			what = why.String()
+		}
+	}
+	fmt.Fprintf(e.w, "  %-8s %s\n", player.Name(), what)
+}
+
+// result writes how the hand just finished was scored
+func (e *explainer) result(game *engine.Game) {
+	if e == nil {
+		return
+	}
+	r := game.Round().Result()
+	switch {
+	case r.Makers < 0:
+		fmt.Fprintln(e.w, "  Thrown in")
+	case r.WasEuchred:
+		var defenders []string
+		for team := 0; team < game.NumTeams(); team++ {
+			if team != r.Makers {
+				defenders = append(defenders, fmt.Sprint(team))
+			}
+		}
+		fmt.Fprintf(e.w, "  Team %d euchred with %d tricks: %s to team %s\n", r.Makers, r.MakerTricks, points(r.DefendPoints), strings.Join(defenders, " and "))
+	default:
+		fmt.Fprintf(e.w, "  Team %d made it with %d tricks: %s\n", r.Makers, r.MakerTricks, points(r.MakerPoints))
+	}
+	scores := make([]string, 0, game.NumTeams())
+	for _, s := range game.Scores() {
+		scores = append(scores, fmt.Sprint(s))
+	}
+	fmt.Fprintf(e.w, "  Score %s\n\n", strings.Join(scores, "-"))
+}
+
+// points formats a number of points, e.g. "1 point" or "4 points"
+func points(n int) string {
+	if n == 1 {
+		return "1 point"
+	}
+	return fmt.Sprintf("%d points", n)
+}
+
+// describeAction names an action for players that give no explanation
+func describeAction(action engine.Action) string {
+	rec := engine.RecordAction(action)
+	s := strings.ToLower(rec.Type.String())
+	if rec.Suit != nil {
+		s += " " + rec.Suit.String()
+	}
+	if rec.Card != nil {
+		s += " " + rec.Card.String()
+	}
+	if rec.Alone {
+		s += " alone"
+	}
+	return s
+}
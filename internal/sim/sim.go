@@ -0,0 +1,253 @@
+// Package sim drives complete Euchre games between AI players without a UI.
+// It is used by the headless `euchre simulate` command to measure how changes
+// to the AI (bidding thresholds, play heuristics) affect results over many
+// games.
+package sim
+
+import (
+	"fmt"
+	"io"
+	"math/rand"
+	"time"
+
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// maxRoundsPerGame bounds a single game so a pathological run of misdeals can
+// never hang a batch. Real games finish in well under 50 rounds.
+const maxRoundsPerGame = 500
+
+// Config describes a batch of headless games.
+type Config struct {
+	Games int               // number of games to play
+	Seed  int64             // master seed; 0 picks a time-based seed
+	Game  engine.GameConfig // rules and deck used for every game
+
+	// Explain, when set, receives every deal, every decision with the AI's
+	// reasons for it, and every hand's result, as text.
+	Explain io.Writer
+}
+
+// Results aggregates the outcome of a batch of games. Per-team counters are
+// indexed by team, one entry per team at the table.
+type Results struct {
+	Seed     int64 // master seed actually used (useful when Config.Seed was 0)
+	Games    int
+	Wins     []int
+	Hands    int // scored hands (misdeals excluded)
+	Misdeals int
+
+	Calls         []int // hands in which the team made trump
+	Euchres       []int // hands in which the team made trump and was euchred
+	LonerAttempts []int // hands in which the team's maker went alone
+	LonerMarches  []int // loner attempts that took every trick
+	Points        []int // total points scored
+}
+
+// newResults returns empty results for a table of numTeams teams
+func newResults(seed int64, numTeams int) Results {
+	return Results{
+		Seed:          seed,
+		Wins:          make([]int, numTeams),
+		Calls:         make([]int, numTeams),
+		Euchres:       make([]int, numTeams),
+		LonerAttempts: make([]int, numTeams),
+		LonerMarches:  make([]int, numTeams),
+		Points:        make([]int, numTeams),
+	}
+}
+
+// Teams returns how many teams the results are kept for
+func (r Results) Teams() int {
+	return len(r.Wins)
+}
+
+// WinRate returns the fraction of games won by the team.
+func (r Results) WinRate(team int) float64 {
+	return ratio(r.Wins[team], r.Games)
+}
+
+// EuchreRate returns the fraction of the team's calls that were euchred.
+func (r Results) EuchreRate(team int) float64 {
+	return ratio(r.Euchres[team], r.Calls[team])
+}
+
+// LonerSuccessRate returns the fraction of the team's loner attempts that
+// marched for 4 points.
+func (r Results) LonerSuccessRate(team int) float64 {
+	return ratio(r.LonerMarches[team], r.LonerAttempts[team])
+}
+
+// PointsPerHand returns the team's average points per scored hand.
+func (r Results) PointsPerHand(team int) float64 {
+	return ratio(r.Points[team], r.Hands)
+}
+
+func ratio(n, d int) float64 {
+	if d == 0 {
+		return 0
+	}
+	return float64(n) / float64(d)
+}
+
+// Run plays cfg.Games complete games with the given players (indexed by seat)
+// and returns the aggregate results. Every deal is derived from the master
+// seed, so the same seed and players always reproduce the same batch.
+func Run(cfg Config, players []ai.Player) (Results, error) {
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	res := newResults(seed, cfg.Game.Rules.TeamCount())
+	rng := rand.New(rand.NewSource(seed))
+
+	for i := 0; i < cfg.Games; i++ {
+		gameCfg := cfg.Game
+		gameCfg.Seed = rng.Int63()
+
+		game := engine.NewGame(gameCfg)
+		var ex *explainer
+		if cfg.Explain != nil {
+			ex = &explainer{w: cfg.Explain, game: i + 1}
+		}
+		misdeals, err := playGame(game, players, ex)
+		if err != nil {
+			return res, fmt.Errorf("game %d: %w", i+1, err)
+		}
+		res.record(game, misdeals)
+	}
+	return res, nil
+}
+
+// record folds one finished game into the results.
+func (r *Results) record(game *engine.Game, misdeals int) {
+	r.Games++
+	r.Misdeals += misdeals
+	if w := game.Winner(); w >= 0 && w < len(r.Wins) {
+		r.Wins[w]++
+	}
+	for _, h := range game.RoundHistory() {
+		if h.Makers < 0 || h.Makers >= len(r.Calls) {
+			continue
+		}
+		r.Hands++
+		r.Calls[h.Makers]++
+		if h.WasEuchred {
+			r.Euchres[h.Makers]++
+			for team := range r.Points {
+				if team != h.Makers {
+					r.Points[team] += h.DefendPoints
+				}
+			}
+		} else {
+			r.Points[h.Makers] += h.MakerPoints
+		}
+		if h.WasAlone {
+			r.LonerAttempts[h.Makers]++
+			if h.MakerTricks == game.Rules().TrickCount() {
+				r.LonerMarches[h.Makers]++
+			}
+		}
+	}
+}
+
+// PlayGame drives game to completion, asking players (indexed by seat) for
+// every decision. It returns the number of misdeals (thrown-in hands) that
+// occurred along the way.
+func PlayGame(game *engine.Game, players []ai.Player) (int, error) {
+	return playGame(game, players, nil)
+}
+
+// playGame is PlayGame, explaining the game to ex when it isn't nil
+func playGame(game *engine.Game, players []ai.Player, ex *explainer) (int, error) {
+	misdeals := 0
+	for rounds := 0; !game.IsOver(); rounds++ {
+		if rounds >= maxRoundsPerGame {
+			return misdeals, fmt.Errorf("game did not finish within %d rounds", maxRoundsPerGame)
+		}
+		game.StartRound()
+		ex.deal(game, players)
+		for !game.IsOver() && !game.NeedsNewRound() {
+			if err := step(game, players, ex); err != nil {
+				return misdeals, err
+			}
+		}
+		ex.result(game)
+		if game.IsMisdeal() {
+			misdeals++
+		}
+	}
+	return misdeals, nil
+}
+
+// Step asks the player whose turn it is for a decision and applies it.
+func Step(game *engine.Game, players []ai.Player) error {
+	return step(game, players, nil)
+}
+
+// step is Step, explaining the decision to ex when it isn't nil
+func step(game *engine.Game, players []ai.Player, ex *explainer) error {
+	current := game.CurrentPlayer()
+	if current < 0 || current >= len(players) || players[current] == nil {
+		return fmt.Errorf("no player for seat %d in phase %s", current, game.Phase())
+	}
+	action, err := Decide(game, players[current])
+	if err != nil {
+		return err
+	}
+	ex.decision(players[current], action)
+	if err := game.ApplyAction(action); err != nil {
+		return fmt.Errorf("seat %d %s: %w", current, action.Type(), err)
+	}
+	return nil
+}
+
+// Decide asks player for its decision in the current phase and converts it
+// into the engine action for the seat whose turn it is.
+func Decide(game *engine.Game, player ai.Player) (engine.Action, error) {
+	current := game.CurrentPlayer()
+	state := engine.NewGameState(game)
+
+	switch phase := game.Phase(); phase {
+	case engine.PhaseBidRound1, engine.PhaseBidRound2:
+		round := 1
+		if phase == engine.PhaseBidRound2 {
+			round = 2
+		}
+		return BidAction(current, phase, player.DecideBid(state, round)), nil
+
+	case engine.PhaseDiscard:
+		card := player.DecideDiscard(state, game.Discardable())
+		return engine.DiscardAction{PlayerIdx: current, Card: card}, nil
+
+	case engine.PhaseDefendAlone:
+		if player.DecideDefendAlone(state) {
+			return engine.DefendAloneAction{PlayerIdx: current}, nil
+		}
+		return engine.PassAction{PlayerIdx: current}, nil
+
+	case engine.PhasePlay:
+		card := player.DecidePlay(state)
+		return engine.PlayCardAction{PlayerIdx: current, Card: card}, nil
+
+	default:
+		return nil, fmt.Errorf("no decision to make in phase %s", phase)
+	}
+}
+
+// BidAction converts an AI bidding decision into the engine action for the
+// given bidding phase.
+func BidAction(playerIdx int, phase engine.GamePhase, decision engine.BidDecision) engine.Action {
+	if decision.Pass {
+		return engine.PassAction{PlayerIdx: playerIdx}
+	}
+	if phase == engine.PhaseBidRound1 {
+		return engine.OrderUpAction{PlayerIdx: playerIdx, Alone: decision.Alone}
+	}
+	return engine.CallTrumpAction{
+		PlayerIdx: playerIdx,
+		Suit:      decision.CallSuit,
+		Alone:     decision.Alone,
+	}
+}
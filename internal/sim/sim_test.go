@@ -0,0 +1,181 @@
+package sim
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/ai/rule_based"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+func allAI(difficulty ai.Difficulty) []ai.Player {
+	players := make([]ai.Player, 4)
+	for i := range players {
+		players[i] = rule_based.New(ai.PlayerNames[i], i, difficulty)
+	}
+	return players
+}
+
+func TestRunCompletesEveryGame(t *testing.T) {
+	cfg := Config{Games: 20, Seed: 42, Game: engine.DefaultGameConfig()}
+	res, err := Run(cfg, allAI(ai.DifficultyMedium))
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	if res.Games != 20 {
+		t.Fatalf("Games = %d, want 20", res.Games)
+	}
+	if res.Wins[0]+res.Wins[1] != res.Games {
+		t.Errorf("every game should have exactly one winner: wins %v over %d games", res.Wins, res.Games)
+	}
+	if res.Calls[0]+res.Calls[1] != res.Hands {
+		t.Errorf("every scored hand should have one maker: calls %v over %d hands", res.Calls, res.Hands)
+	}
+	for team := 0; team < 2; team++ {
+		if res.Euchres[team] > res.Calls[team] {
+			t.Errorf("team %d euchred %d times on only %d calls", team, res.Euchres[team], res.Calls[team])
+		}
+		if res.LonerMarches[team] > res.LonerAttempts[team] {
+			t.Errorf("team %d marched %d loners on only %d attempts", team, res.LonerMarches[team], res.LonerAttempts[team])
+		}
+	}
+}
+
+func TestRunIsReproducibleFromSeed(t *testing.T) {
+	cfg := Config{Games: 10, Seed: 7, Game: engine.DefaultGameConfig()}
+
+	first, err := Run(cfg, allAI(ai.DifficultyMedium))
+	if err != nil {
+		t.Fatalf("first run failed: %v", err)
+	}
+	second, err := Run(cfg, allAI(ai.DifficultyMedium))
+	if err != nil {
+		t.Fatalf("second run failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("same seed produced different results:\n%+v\n%+v", first, second)
+	}
+}
+
+func TestBidActionMapsDecisionToPhase(t *testing.T) {
+	if a := BidAction(1, engine.PhaseBidRound1, engine.BidDecision{Pass: true}); a.Type() != engine.ActionPass {
+		t.Errorf("pass decision should map to a pass, got %s", a.Type())
+	}
+
+	a := BidAction(2, engine.PhaseBidRound1, engine.BidDecision{OrderUp: true, Alone: true})
+	order, ok := a.(engine.OrderUpAction)
+	if !ok || order.PlayerIdx != 2 || !order.Alone {
+		t.Errorf("round-1 bid should map to an alone order-up by seat 2, got %#v", a)
+	}
+
+	a = BidAction(3, engine.PhaseBidRound2, engine.BidDecision{CallSuit: engine.Spades})
+	call, ok := a.(engine.CallTrumpAction)
+	if !ok || call.PlayerIdx != 3 || call.Suit != engine.Spades || call.Alone {
+		t.Errorf("round-2 bid should map to a spades call by seat 3, got %#v", a)
+	}
+}
+
+func TestHardBeatsEasy(t *testing.T) {
+	cfg := Config{Games: 400, Seed: 2024, Game: engine.DefaultGameConfig()}
+
+	// Play from both sides of the table so seat order can't decide it.
+	for hardTeam := 0; hardTeam < 2; hardTeam++ {
+		players := make([]ai.Player, 4)
+		for i := range players {
+			difficulty := ai.DifficultyEasy
+			if engine.Team(i) == hardTeam {
+				difficulty = ai.DifficultyHard
+			}
+			players[i] = rule_based.New(ai.PlayerNames[i], i, difficulty)
+		}
+
+		res, err := Run(cfg, players)
+		if err != nil {
+			t.Fatalf("Run failed: %v", err)
+		}
+		if rate := res.WinRate(hardTeam); rate < 0.6 {
+			t.Errorf("Hard as team %d won only %.1f%% of %d games against Easy", hardTeam, 100*rate, res.Games)
+		}
+	}
+}
+
+func TestExplainNarratesEveryDecision(t *testing.T) {
+	var out strings.Builder
+	cfg := Config{Games: 1, Seed: 3, Game: engine.DefaultGameConfig(), Explain: &out}
+	res, err := Run(cfg, allAI(ai.DifficultyMedium))
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+
+	text := out.String()
+	for _, want := range []string{"Game 1, deal 1:", "strength", "needs 55", "play ", "Score "} {
+		if !strings.Contains(text, want) {
+			t.Errorf("explained game lacks %q:\n%s", want, text)
+		}
+	}
+	// Every scored hand and thrown-in hand ends with the score.
+	if got, want := strings.Count(text, "Score "), res.Hands+res.Misdeals; got != want {
+		t.Errorf("%d hand results explained, want %d", got, want)
+	}
+}
+
+// TestBritishGamesPlayToTheEnd plays British Euchre, where the Benny is the
+// top trump and a turned-up Benny forces the dealer to call, with AIs that
+// sample the unseen cards from the 25-card pack.
+func TestBritishGamesPlayToTheEnd(t *testing.T) {
+	game := engine.DefaultGameConfig()
+	game.DeckConfig = engine.BritishDeckConfig{}
+	players := allAI(ai.DifficultyMedium)
+	players[1] = rule_based.New(ai.PlayerNames[1], 1, ai.DifficultyHard)
+	players[3] = rule_based.New(ai.PlayerNames[3], 3, ai.DifficultyHard)
+
+	var out strings.Builder
+	res, err := Run(Config{Games: 10, Seed: 25, Game: game, Explain: &out}, players)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if res.Wins[0]+res.Wins[1] != res.Games {
+		t.Errorf("every game should have exactly one winner: wins %v over %d games", res.Wins, res.Games)
+	}
+	if !strings.Contains(out.String(), "Joker turned up") {
+		t.Error("no deal turned up the Benny; pick a seed that does")
+	}
+}
+
+// TestCutthroatGamesPlayToTheEnd plays three-handed games, where each player
+// is a team of one and a euchre pays both opponents.
+func TestCutthroatGamesPlayToTheEnd(t *testing.T) {
+	game := engine.DefaultGameConfig()
+	game.NumPlayers = 3
+	game.Rules.Teams = 3
+	players := allAI(ai.DifficultyMedium)[:3]
+	players[1] = rule_based.New(ai.PlayerNames[1], 1, ai.DifficultyHard)
+
+	var out strings.Builder
+	res, err := Run(Config{Games: 10, Seed: 3, Game: game, Explain: &out}, players)
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if res.Teams() != 3 {
+		t.Fatalf("results kept for %d teams, want 3", res.Teams())
+	}
+	if res.Wins[0]+res.Wins[1]+res.Wins[2] != res.Games {
+		t.Errorf("every game should have exactly one winner: wins %v over %d games", res.Wins, res.Games)
+	}
+	euchred := false
+	for _, line := range strings.Split(out.String(), "\n") {
+		if strings.Contains(line, "euchred with") {
+			euchred = true
+			if !strings.Contains(line, " and ") {
+				t.Errorf("a euchre should pay both opponents: %q", line)
+			}
+		}
+	}
+	if !euchred {
+		t.Error("no hand was euchred; pick a seed where one is")
+	}
+}
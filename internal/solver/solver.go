@@ -0,0 +1,358 @@
+// Package solver is a perfect-information ("double-dummy") solver for the
+// trick-play phase of a Euchre hand. Given every seat's cards, trump, who is
+// sitting out and the trick in progress, it finds how many of the remaining
+// tricks each team takes when everyone plays perfectly.
+//
+// It serves both as an analysis tool ("could I have made this?") and as the
+// inner search of sampling-based AIs, which guess the hidden cards many times
+// and solve each guess.
+package solver
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+// Position is a trick-play position with every hand known. Seat p plays for
+// team p % Teams, as at the table.
+type Position struct {
+	Hands         [][]engine.Card  // each seat's playable cards, indexed by seat
+	Stacks        [][]engine.Stack // each seat's face-down cards still under a face-up card in Hands
+	Trump         engine.Suit
+	Maker         int  // seat that called trump
+	Alone         bool // the maker is playing without their partner
+	AloneDefender int  // seat defending alone, or -1
+	Trick         *engine.Trick
+	Leader        int // seat to lead when Trick is empty
+	Teams         int // teams the seats are split into; 0 means the usual 2
+}
+
+// FromRound captures the round's trick-play position with every hand visible,
+// face-down stack cards included.
+func FromRound(r *engine.Round) (Position, error) {
+	if r.Phase() != engine.PhasePlay {
+		return Position{}, fmt.Errorf("round is in %s, not play", r.Phase())
+	}
+	p := Position{
+		Hands:         make([][]engine.Card, r.NumPlayers()),
+		Stacks:        make([][]engine.Stack, r.NumPlayers()),
+		Trump:         r.Trump(),
+		Maker:         r.Maker(),
+		Alone:         r.IsAlone(),
+		AloneDefender: r.AloneDefender(),
+		Trick:         r.Trick().Clone(),
+		Leader:        r.CurrentPlayer(),
+		Teams:         r.NumTeams(),
+	}
+	for i := range p.Hands {
+		p.Hands[i] = r.Hand(i)
+		p.Stacks[i] = r.Stacks(i)
+	}
+	return p, nil
+}
+
+// Result is the outcome of perfect play from a position
+type Result struct {
+	Tricks [2]int // remaining tricks taken by each team
+}
+
+// PlayValue is how a candidate card works out under perfect play
+type PlayValue struct {
+	Card   engine.Card
+	Tricks [2]int // remaining tricks taken by each team after playing Card
+}
+
+// maxCards is the most distinct cards a position can hold; hands are stored
+// as bit sets over them.
+const maxCards = 64
+
+// solver holds one position's card index and transposition cache.
+type solver struct {
+	cards    []engine.Card // bit index → card
+	covers   []cover       // face-down cards not playable while their face-up card is held
+	trump    engine.Suit
+	seats    []int // seats that play, in turn order
+	numSeats int
+	teams    int
+	cache    map[cacheKey]int
+}
+
+// cover is a face-down stack card and the face-up card on it, as bit sets.
+type cover struct {
+	down, up uint64
+}
+
+// cacheKey identifies a position at a trick boundary: who holds what and who
+// leads. Positions inside a trick are searched, not cached.
+type cacheKey struct {
+	hands  [6]uint64
+	leader int
+}
+
+// Solve computes how many of the remaining tricks each team takes with
+// optimal play by everyone from pos.
+func Solve(pos Position) (Result, error) {
+	s, hands, err := newSolver(pos)
+	if err != nil {
+		return Result{}, err
+	}
+	seat := s.nextSeat(pos)
+	total := popcount(hands[seat])
+	team0 := s.playTrick(hands, pos.trick(), seat, 0, total)
+	return Result{Tricks: [2]int{team0, total - team0}}, nil
+}
+
+// EvaluatePlays solves the position after each legal card of the seat to
+// move, in the order engine.LegalPlays returns them.
+func EvaluatePlays(pos Position) ([]PlayValue, error) {
+	s, hands, err := newSolver(pos)
+	if err != nil {
+		return nil, err
+	}
+	trick := pos.trick()
+	seat := s.nextSeat(pos)
+	// The seat to move holds one card for every trick left, this one included.
+	total := popcount(hands[seat])
+
+	var out []PlayValue
+	for _, card := range engine.LegalPlays(engine.NewHandWith(pos.Hands[seat]), trick) {
+		next, nextHands := s.play(hands, trick, seat, card)
+		team0 := s.continueTrick(nextHands, next, 0, total)
+		out = append(out, PlayValue{Card: card, Tricks: [2]int{team0, total - team0}})
+	}
+	return out, nil
+}
+
+// teamOf returns the team seat plays for when the seats are split into
+// teams, 0 meaning the usual 2.
+func teamOf(seat, teams int) int {
+	if teams > 0 {
+		return seat % teams
+	}
+	return engine.Team(seat)
+}
+
+// trick returns the position's trick in progress, never nil.
+func (p Position) trick() *engine.Trick {
+	if p.Trick == nil {
+		return engine.NewTrick(p.Trump)
+	}
+	return p.Trick
+}
+
+// sittingOut reports whether seat takes no part in the play: it is a
+// partner of a lone maker or lone defender.
+func (p Position) sittingOut(seat int) bool {
+	partner := func(lone int) bool {
+		return seat != lone && teamOf(seat, p.Teams) == teamOf(lone, p.Teams)
+	}
+	if p.Alone && partner(p.Maker) {
+		return true
+	}
+	return p.AloneDefender >= 0 && partner(p.AloneDefender)
+}
+
+// newSolver indexes the position's cards and checks it is playable.
+func newSolver(pos Position) (*solver, []uint64, error) {
+	n := len(pos.Hands)
+	if n == 0 || n > len(cacheKey{}.hands) {
+		return nil, nil, fmt.Errorf("unsupported number of seats %d", n)
+	}
+	// The search is two-sided, team 0 against team 1.
+	if pos.Teams > 2 {
+		return nil, nil, fmt.Errorf("unsupported number of teams %d", pos.Teams)
+	}
+	s := &solver{
+		trump:    pos.Trump,
+		numSeats: n,
+		teams:    pos.Teams,
+		cache:    make(map[cacheKey]int),
+	}
+	index := make(map[engine.Card]int)
+	hands := make([]uint64, n)
+	add := func(seat int, c engine.Card) (uint64, error) {
+		if _, dup := index[c]; dup {
+			return 0, fmt.Errorf("%s is in more than one hand", c)
+		}
+		if len(s.cards) == maxCards {
+			return 0, errors.New("too many cards in position")
+		}
+		bit := uint64(1) << len(s.cards)
+		hands[seat] |= bit
+		index[c] = len(s.cards)
+		s.cards = append(s.cards, c)
+		return bit, nil
+	}
+	for seat := 0; seat < n; seat++ {
+		if pos.sittingOut(seat) {
+			continue
+		}
+		s.seats = append(s.seats, seat)
+		for _, c := range pos.Hands[seat] {
+			if _, err := add(seat, c); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	// Face-down cards are held from the start, but only become playable
+	// once the card on top of them has gone.
+	for _, seat := range s.seats {
+		if seat >= len(pos.Stacks) {
+			break
+		}
+		for _, st := range pos.Stacks[seat] {
+			up, ok := index[st.Up]
+			if !ok || hands[seat]&(1<<up) == 0 {
+				return nil, nil, fmt.Errorf("face-up %s is not in seat %d's hand", st.Up, seat)
+			}
+			down, err := add(seat, st.Down)
+			if err != nil {
+				return nil, nil, err
+			}
+			s.covers = append(s.covers, cover{down: down, up: 1 << up})
+		}
+	}
+	if pos.Trick != nil && pos.Trick.Trump() != pos.Trump {
+		return nil, nil, errors.New("trick trump does not match position trump")
+	}
+	return s, hands, nil
+}
+
+// nextSeat returns the seat to play next in the position.
+func (s *solver) nextSeat(pos Position) int {
+	trick := pos.trick()
+	if trick.Size() == 0 {
+		return pos.Leader
+	}
+	played := trick.Cards()
+	return s.after(played[len(played)-1].Player)
+}
+
+// after returns the next playing seat clockwise from seat.
+func (s *solver) after(seat int) int {
+	for i := 1; i <= s.numSeats; i++ {
+		next := (seat + i) % s.numSeats
+		for _, p := range s.seats {
+			if p == next {
+				return next
+			}
+		}
+	}
+	return seat
+}
+
+// play returns the trick and hands after seat plays card.
+func (s *solver) play(hands []uint64, trick *engine.Trick, seat int, card engine.Card) (*engine.Trick, []uint64) {
+	next := trick.Clone()
+	next.Play(seat, card)
+	nextHands := append([]uint64(nil), hands...)
+	for i, c := range s.cards {
+		if c == card {
+			nextHands[seat] &^= 1 << i
+			break
+		}
+	}
+	return next, nextHands
+}
+
+// continueTrick resolves the trick if every playing seat has played, else
+// searches the next seat's plays. It returns the tricks team 0 takes.
+func (s *solver) continueTrick(hands []uint64, trick *engine.Trick, alpha, beta int) int {
+	if trick.Size() < len(s.seats) {
+		played := trick.Cards()
+		return s.playTrick(hands, trick, s.after(played[len(played)-1].Player), alpha, beta)
+	}
+	winner := trick.Winner()
+	won := 0
+	if teamOf(winner, s.teams) == 0 {
+		won = 1
+	}
+	return won + s.boundary(hands, winner)
+}
+
+// boundary returns the tricks team 0 takes from the start of a trick. These
+// positions recur through many play orders, so they are cached.
+func (s *solver) boundary(hands []uint64, leader int) int {
+	if hands[leader] == 0 {
+		return 0
+	}
+	var key cacheKey
+	copy(key.hands[:], hands)
+	key.leader = leader
+	if v, ok := s.cache[key]; ok {
+		return v
+	}
+	v := s.playTrick(hands, engine.NewTrick(s.trump), leader, 0, popcount(hands[leader]))
+	s.cache[key] = v
+	return v
+}
+
+// playTrick is alpha-beta over seat's legal plays: team 0 maximizes the
+// tricks it takes, team 1 minimizes them. The result is exact whenever it
+// falls strictly inside (alpha, beta).
+func (s *solver) playTrick(hands []uint64, trick *engine.Trick, seat int, alpha, beta int) int {
+	if hands[seat] == 0 {
+		return 0
+	}
+	maximize := teamOf(seat, s.teams) == 0
+	best := beta
+	if maximize {
+		best = alpha
+	}
+	for _, card := range engine.LegalPlays(engine.NewHandWith(s.handCards(s.playable(hands[seat]))), trick) {
+		next, nextHands := s.play(hands, trick, seat, card)
+		v := s.continueTrick(nextHands, next, alpha, beta)
+		if maximize {
+			if v > best {
+				best = v
+			}
+			if best > alpha {
+				alpha = best
+			}
+		} else {
+			if v < best {
+				best = v
+			}
+			if best < beta {
+				beta = best
+			}
+		}
+		if alpha >= beta {
+			break
+		}
+	}
+	return best
+}
+
+// playable returns the cards of a hand bit set that can be played: all but
+// the face-down cards still covered.
+func (s *solver) playable(hand uint64) uint64 {
+	for _, c := range s.covers {
+		if hand&c.up != 0 {
+			hand &^= c.down
+		}
+	}
+	return hand
+}
+
+// handCards lists the cards in a hand bit set.
+func (s *solver) handCards(hand uint64) []engine.Card {
+	var cards []engine.Card
+	for i, c := range s.cards {
+		if hand&(1<<i) != 0 {
+			cards = append(cards, c)
+		}
+	}
+	return cards
+}
+
+// popcount returns the number of cards in a hand bit set.
+func popcount(hand uint64) int {
+	n := 0
+	for ; hand != 0; hand &= hand - 1 {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,236 @@
+package solver
+
+import (
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+var c = engine.NewCard
+
+func TestTopTrumpsTakeEveryTrick(t *testing.T) {
+	pos := Position{
+		Hands: [][]engine.Card{
+			{c(engine.Hearts, engine.Jack), c(engine.Diamonds, engine.Jack), c(engine.Hearts, engine.Ace), c(engine.Hearts, engine.King), c(engine.Hearts, engine.Queen)},
+			{c(engine.Clubs, engine.Nine), c(engine.Clubs, engine.Ten), c(engine.Clubs, engine.Queen), c(engine.Clubs, engine.King), c(engine.Clubs, engine.Ace)},
+			{c(engine.Spades, engine.Nine), c(engine.Spades, engine.Ten), c(engine.Spades, engine.Queen), c(engine.Spades, engine.King), c(engine.Spades, engine.Ace)},
+			{c(engine.Hearts, engine.Nine), c(engine.Hearts, engine.Ten), c(engine.Diamonds, engine.Queen), c(engine.Diamonds, engine.King), c(engine.Diamonds, engine.Ace)},
+		},
+		Trump:         engine.Hearts,
+		Maker:         0,
+		AloneDefender: -1,
+		Leader:        1,
+	}
+	res, err := Solve(pos)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if res.Tricks != [2]int{5, 0} {
+		t.Errorf("holding the five top trumps should take every trick, got %v", res.Tricks)
+	}
+}
+
+func TestLonerSkipsTheSittingOutPartner(t *testing.T) {
+	// Seat 0 goes alone with the right bower, the ace of trump and three
+	// off-suit aces. Seat 2's strong spades sit out, so the lone left bower in
+	// seat 1 is the only trump against it and falls to the right.
+	pos := Position{
+		Hands: [][]engine.Card{
+			{c(engine.Spades, engine.Jack), c(engine.Spades, engine.Ace), c(engine.Hearts, engine.Ace), c(engine.Diamonds, engine.Ace), c(engine.Clubs, engine.Ace)},
+			{c(engine.Clubs, engine.Jack), c(engine.Hearts, engine.Nine), c(engine.Hearts, engine.Ten), c(engine.Diamonds, engine.Nine), c(engine.Diamonds, engine.Ten)},
+			{c(engine.Spades, engine.King), c(engine.Spades, engine.Queen), c(engine.Spades, engine.Ten), c(engine.Spades, engine.Nine), c(engine.Hearts, engine.King)},
+			{c(engine.Clubs, engine.Nine), c(engine.Clubs, engine.Ten), c(engine.Clubs, engine.Queen), c(engine.Clubs, engine.King), c(engine.Hearts, engine.Queen)},
+		},
+		Trump:         engine.Spades,
+		Maker:         0,
+		Alone:         true,
+		AloneDefender: -1,
+		Leader:        1,
+	}
+	res, err := Solve(pos)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if res.Tricks[0]+res.Tricks[1] != 5 {
+		t.Fatalf("tricks should add up to 5, got %v", res.Tricks)
+	}
+	if res.Tricks[0] != 5 {
+		t.Errorf("the loner should take every trick, got %v", res.Tricks)
+	}
+}
+
+// roundAfterTricks deals a seeded round, orders up and plays the given number
+// of tricks with the first legal card each time.
+func roundAfterTricks(t *testing.T, seed int64, tricks int) *engine.Round {
+	t.Helper()
+	round := engine.NewRound(4, 0)
+	deck := engine.NewStandardDeck()
+	deck.Seed(seed)
+	round.Deal(deck)
+	if err := round.ApplyAction(engine.OrderUpAction{PlayerIdx: 1}); err != nil {
+		t.Fatalf("order up failed: %v", err)
+	}
+	if err := round.ApplyAction(engine.DiscardAction{PlayerIdx: 0, Card: round.Hand(0)[0]}); err != nil {
+		t.Fatalf("discard failed: %v", err)
+	}
+	for len(round.TrickHistory()) < tricks {
+		if err := round.ApplyAction(round.LegalActions()[0]); err != nil {
+			t.Fatalf("play failed: %v", err)
+		}
+	}
+	return round
+}
+
+// bruteForce plays every line out on the real engine and returns the tricks
+// team 0 takes from here with perfect play.
+func bruteForce(r *engine.Round, before int) int {
+	if r.IsComplete() {
+		return r.TeamTricksWon(0) - before
+	}
+	maximize := engine.Team(r.CurrentPlayer()) == 0
+	best := -1
+	for _, a := range r.LegalActions() {
+		next := r.Clone()
+		if err := next.ApplyAction(a); err != nil {
+			panic(err)
+		}
+		v := bruteForce(next, before)
+		if best < 0 || (maximize && v > best) || (!maximize && v < best) {
+			best = v
+		}
+	}
+	return best
+}
+
+func TestSolveMatchesBruteForce(t *testing.T) {
+	for seed := int64(1); seed <= 20; seed++ {
+		round := roundAfterTricks(t, seed, 2)
+		// Play into the third trick too, so a trick in progress is covered.
+		if seed%2 == 0 {
+			if err := round.ApplyAction(round.LegalActions()[0]); err != nil {
+				t.Fatalf("play failed: %v", err)
+			}
+		}
+		pos, err := FromRound(round)
+		if err != nil {
+			t.Fatalf("FromRound failed: %v", err)
+		}
+		res, err := Solve(pos)
+		if err != nil {
+			t.Fatalf("Solve failed: %v", err)
+		}
+		want := bruteForce(round, round.TeamTricksWon(0))
+		if res.Tricks[0] != want || res.Tricks[0]+res.Tricks[1] != 3 {
+			t.Errorf("seed %d: solver says %v, brute force says team 0 takes %d of 3", seed, res.Tricks, want)
+		}
+	}
+}
+
+func TestEvaluatePlaysAgreesWithSolve(t *testing.T) {
+	for seed := int64(1); seed <= 10; seed++ {
+		round := roundAfterTricks(t, seed, 1)
+		pos, err := FromRound(round)
+		if err != nil {
+			t.Fatalf("FromRound failed: %v", err)
+		}
+		res, err := Solve(pos)
+		if err != nil {
+			t.Fatalf("Solve failed: %v", err)
+		}
+		plays, err := EvaluatePlays(pos)
+		if err != nil {
+			t.Fatalf("EvaluatePlays failed: %v", err)
+		}
+		legal := engine.LegalPlays(engine.NewHandWith(round.Hand(round.CurrentPlayer())), round.Trick())
+		if len(plays) != len(legal) {
+			t.Fatalf("seed %d: %d plays evaluated, %d legal", seed, len(plays), len(legal))
+		}
+
+		team := engine.Team(round.CurrentPlayer())
+		best := -1
+		for _, p := range plays {
+			if p.Tricks[0]+p.Tricks[1] != 4 {
+				t.Fatalf("seed %d: %s splits %v, want 4 tricks", seed, p.Card, p.Tricks)
+			}
+			best = max(best, p.Tricks[team])
+		}
+		if best != res.Tricks[team] {
+			t.Errorf("seed %d: best play takes %d, Solve says %d", seed, best, res.Tricks[team])
+		}
+	}
+}
+
+func TestSolveRejectsADuplicatedCard(t *testing.T) {
+	ace := c(engine.Spades, engine.Ace)
+	pos := Position{
+		Hands:         [][]engine.Card{{ace}, {ace}, {c(engine.Spades, engine.Nine)}, {c(engine.Spades, engine.Ten)}},
+		Trump:         engine.Spades,
+		AloneDefender: -1,
+	}
+	if _, err := Solve(pos); err == nil {
+		t.Error("a card in two hands should be rejected")
+	}
+}
+
+func TestStackCardsWaitForTheCardOnTop(t *testing.T) {
+	// Seat 0 leads; its right bower lies under the nine of clubs, so it has
+	// to give up the club trick before the bower can take the last one.
+	pos := Position{
+		Hands: [][]engine.Card{
+			{c(engine.Clubs, engine.Nine)},
+			{c(engine.Clubs, engine.Ace), c(engine.Spades, engine.Nine)},
+		},
+		Stacks:        [][]engine.Stack{{{Down: c(engine.Hearts, engine.Jack), Up: c(engine.Clubs, engine.Nine)}}, nil},
+		Trump:         engine.Hearts,
+		AloneDefender: -1,
+	}
+	res, err := Solve(pos)
+	if err != nil {
+		t.Fatalf("Solve failed: %v", err)
+	}
+	if res.Tricks != [2]int{1, 1} {
+		t.Errorf("the covered bower should only take the second trick, got %v", res.Tricks)
+	}
+}
+
+func TestSolveMatchesBruteForceWithStacks(t *testing.T) {
+	stacked := 0
+	for seed := int64(1); seed <= 20; seed++ {
+		round := engine.NewRoundWithRules(2, 0, engine.Rules{Stacks: 3})
+		deck := engine.NewStandardDeck()
+		deck.Seed(seed)
+		round.Deal(deck)
+		if err := round.ApplyAction(engine.OrderUpAction{PlayerIdx: 1}); err != nil {
+			t.Fatalf("order up failed: %v", err)
+		}
+		if err := round.ApplyAction(engine.DiscardAction{PlayerIdx: 0, Card: round.Discardable()[0]}); err != nil {
+			t.Fatalf("discard failed: %v", err)
+		}
+		// Play the last legal card, which tends to leave the stacks covered.
+		for len(round.TrickHistory()) < 6 {
+			legal := round.LegalActions()
+			if err := round.ApplyAction(legal[len(legal)-1]); err != nil {
+				t.Fatalf("play failed: %v", err)
+			}
+		}
+		if len(round.Stacks(0))+len(round.Stacks(1)) > 0 {
+			stacked++
+		}
+
+		pos, err := FromRound(round)
+		if err != nil {
+			t.Fatalf("FromRound failed: %v", err)
+		}
+		res, err := Solve(pos)
+		if err != nil {
+			t.Fatalf("Solve failed: %v", err)
+		}
+		want := bruteForce(round, round.TeamTricksWon(0))
+		if res.Tricks[0] != want || res.Tricks[0]+res.Tricks[1] != 5 {
+			t.Errorf("seed %d: solver says %v, brute force says seat 0 takes %d of 5", seed, res.Tricks, want)
+		}
+	}
+	if stacked == 0 {
+		t.Fatal("no deal had a face-down card left to test")
+	}
+}
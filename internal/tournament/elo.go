@@ -0,0 +1,162 @@
+package tournament
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+const (
+	// baseElo is the average rating of a tournament's entries
+	baseElo = 1500
+
+	// bootstrapSamples is how many resampled tournaments the confidence
+	// intervals are read from
+	bootstrapSamples = 1000
+
+	// priorGames is a drawn pseudo-game added to every pairing so an entry
+	// that won or lost every game still gets a finite rating
+	priorGames = 1
+)
+
+// Rating is an entry's Elo with a 95% confidence interval
+type Rating struct {
+	Entry     int // index into Results.Entries
+	Elo       float64
+	Low, High float64
+	Games     int
+	Wins      int
+}
+
+// WinRate returns the fraction of its games the entry won
+func (r Rating) WinRate() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return float64(r.Wins) / float64(r.Games)
+}
+
+// Ratings fits Elo ratings to every game of the tournament (a Bradley-Terry
+// model, averaging baseElo) and returns them best first. The confidence
+// intervals come from refitting tournaments whose boards are resampled with
+// replacement; a board's two games share their deals, so they are resampled
+// together.
+func (r Results) Ratings() []Rating {
+	n := len(r.Entries)
+	ratings := make([]Rating, n)
+	for i := range ratings {
+		ratings[i].Entry = i
+	}
+	for _, p := range r.Pairings {
+		ratings[p.A].Games += p.Games()
+		ratings[p.B].Games += p.Games()
+		ratings[p.A].Wins += p.Wins[0]
+		ratings[p.B].Wins += p.Wins[1]
+	}
+
+	elo := fitElo(n, r.Pairings)
+	rng := rand.New(rand.NewSource(r.Seed))
+	samples := make([][]float64, n)
+	for s := 0; s < bootstrapSamples; s++ {
+		for i, e := range fitElo(n, resample(r.Pairings, rng)) {
+			samples[i] = append(samples[i], e)
+		}
+	}
+	for i := range ratings {
+		ratings[i].Elo = elo[i]
+		sort.Float64s(samples[i])
+		ratings[i].Low = percentile(samples[i], 0.025)
+		ratings[i].High = percentile(samples[i], 0.975)
+	}
+
+	sort.SliceStable(ratings, func(i, j int) bool { return ratings[i].Elo > ratings[j].Elo })
+	return ratings
+}
+
+// resample draws each pairing's boards again, with replacement, from the
+// boards it actually played.
+func resample(pairings []Pairing, rng *rand.Rand) []Pairing {
+	out := make([]Pairing, len(pairings))
+	for i, p := range pairings {
+		boards := p.Boards[0] + p.Boards[1] + p.Boards[2]
+		q := Pairing{A: p.A, B: p.B}
+		for b := 0; b < boards; b++ {
+			pick := rng.Intn(boards)
+			won := 0
+			for pick >= p.Boards[won] {
+				pick -= p.Boards[won]
+				won++
+			}
+			q.Boards[won]++
+			q.Wins[0] += won
+			q.Wins[1] += 2 - won
+		}
+		out[i] = q
+	}
+	return out
+}
+
+// fitElo finds the Bradley-Terry strengths that best explain the pairings'
+// results, by minorization-maximization, and converts them to Elo.
+func fitElo(n int, pairings []Pairing) []float64 {
+	wins := make([][]float64, n)
+	for i := range wins {
+		wins[i] = make([]float64, n)
+	}
+	for _, p := range pairings {
+		wins[p.A][p.B] += float64(p.Wins[0]) + priorGames/2.0
+		wins[p.B][p.A] += float64(p.Wins[1]) + priorGames/2.0
+	}
+
+	strength := make([]float64, n)
+	for i := range strength {
+		strength[i] = 1
+	}
+	next := make([]float64, n)
+	for iter := 0; iter < 10000; iter++ {
+		for i := range next {
+			var won, denom float64
+			for j := range strength {
+				if games := wins[i][j] + wins[j][i]; j != i && games > 0 {
+					won += wins[i][j]
+					denom += games / (strength[i] + strength[j])
+				}
+			}
+			next[i] = strength[i]
+			if denom > 0 {
+				next[i] = won / denom
+			}
+		}
+
+		// Fix the scale: the strengths' geometric mean is 1.
+		var logSum float64
+		for _, s := range next {
+			logSum += math.Log(s)
+		}
+		scale := math.Exp(logSum / float64(n))
+		change := 0.0
+		for i := range next {
+			next[i] /= scale
+			change = math.Max(change, math.Abs(next[i]-strength[i])/strength[i])
+		}
+		strength, next = next, strength
+		if change < 1e-10 {
+			break
+		}
+	}
+
+	elo := make([]float64, n)
+	for i, s := range strength {
+		elo[i] = baseElo + 400*math.Log10(s)
+	}
+	return elo
+}
+
+// percentile returns the q-th quantile of sorted values
+func percentile(sorted []float64, q float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	i := int(math.Round(q * float64(len(sorted)-1)))
+	return sorted[i]
+}
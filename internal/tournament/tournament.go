@@ -0,0 +1,198 @@
+// Package tournament plays round-robin matches between AI configurations
+// and rates them. Every pair of entries meets as partnerships over a set of
+// seeded boards; each board is played twice with the same deals and the
+// partnerships' seats swapped, so neither side gets the better cards.
+package tournament
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/sim"
+)
+
+// Entry is one AI configuration in a tournament. Both seats of its
+// partnership play it.
+type Entry struct {
+	Name       string // label in reports
+	Strategy   string // registered ai strategy, external bots included
+	Difficulty ai.Difficulty
+}
+
+// ParseEntry parses an entry spec of the form [NAME=]STRATEGY[:DIFFICULTY],
+// e.g. "mcts:hard" or "base=rule-based". The difficulty defaults to medium
+// and the name to the spec itself.
+func ParseEntry(spec string) (Entry, error) {
+	e := Entry{Name: spec, Difficulty: ai.DifficultyMedium}
+	rest := spec
+	if name, after, ok := strings.Cut(spec, "="); ok {
+		e.Name, rest = name, after
+	}
+	strategy, difficulty, hasDifficulty := strings.Cut(rest, ":")
+	e.Strategy = strategy
+	if hasDifficulty {
+		d, err := ai.ParseDifficulty(difficulty)
+		if err != nil {
+			return Entry{}, fmt.Errorf("entry %q: %w", spec, err)
+		}
+		e.Difficulty = d
+	}
+	if e.Name == "" || e.Strategy == "" {
+		return Entry{}, fmt.Errorf("entry %q: want [NAME=]STRATEGY[:DIFFICULTY]", spec)
+	}
+	return e, nil
+}
+
+// Config describes a tournament
+type Config struct {
+	Entries []Entry
+	Boards  int               // boards per pairing; each is played twice
+	Seed    int64             // master seed; 0 picks a time-based seed
+	Game    engine.GameConfig // rules and deck used for every game
+
+	// Progress, if set, is called after each pairing finishes
+	Progress func(Pairing)
+}
+
+// Pairing is the record of two entries' match. Counters indexed [2] are
+// for A and B in that order.
+type Pairing struct {
+	A, B   int    // indexes into Results.Entries
+	Wins   [2]int // games won
+	Boards [3]int // boards on which A won 0, 1 or 2 of the two games
+}
+
+// Games returns the number of games played in the pairing
+func (p Pairing) Games() int {
+	return p.Wins[0] + p.Wins[1]
+}
+
+// Results is the outcome of a tournament
+type Results struct {
+	Seed     int64 // master seed actually used
+	Entries  []Entry
+	Pairings []Pairing
+}
+
+// Pairing returns the match between entries a and b, oriented so that
+// Wins[0] is a's, and false if they didn't meet.
+func (r Results) Pairing(a, b int) (Pairing, bool) {
+	for _, p := range r.Pairings {
+		switch {
+		case p.A == a && p.B == b:
+			return p, true
+		case p.A == b && p.B == a:
+			return Pairing{
+				A:      a,
+				B:      b,
+				Wins:   [2]int{p.Wins[1], p.Wins[0]},
+				Boards: [3]int{p.Boards[2], p.Boards[1], p.Boards[0]},
+			}, true
+		}
+	}
+	return Pairing{}, false
+}
+
+// Run plays every pairing of the entries. Each entry's players are created
+// once per seat and reused, then closed when the tournament ends.
+func Run(cfg Config) (Results, error) {
+	if len(cfg.Entries) < 2 {
+		return Results{}, fmt.Errorf("a tournament needs at least 2 entries, got %d", len(cfg.Entries))
+	}
+	if cfg.Boards <= 0 {
+		return Results{}, fmt.Errorf("boards must be positive, got %d", cfg.Boards)
+	}
+	if teams := cfg.Game.Rules.TeamCount(); teams != 2 {
+		return Results{}, fmt.Errorf("a tournament needs two partnerships, not %d teams", teams)
+	}
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	res := Results{Seed: seed, Entries: cfg.Entries}
+	rng := rand.New(rand.NewSource(seed))
+
+	seats := newSeating(cfg.Entries)
+	defer seats.close()
+
+	for a := range cfg.Entries {
+		for b := a + 1; b < len(cfg.Entries); b++ {
+			p := Pairing{A: a, B: b}
+			for board := 0; board < cfg.Boards; board++ {
+				won, err := playBoard(cfg.Game, rng.Int63(), seats, a, b)
+				if err != nil {
+					return res, fmt.Errorf("%s vs %s, board %d: %w", cfg.Entries[a].Name, cfg.Entries[b].Name, board+1, err)
+				}
+				p.Wins[0] += won
+				p.Wins[1] += 2 - won
+				p.Boards[won]++
+			}
+			res.Pairings = append(res.Pairings, p)
+			if cfg.Progress != nil {
+				cfg.Progress(p)
+			}
+		}
+	}
+	return res, nil
+}
+
+// playBoard plays one seeded board twice, with a's partnership first on
+// team 0 and then on team 1, and returns how many of the two games a won.
+func playBoard(gameCfg engine.GameConfig, seed int64, seats *seating, a, b int) (int, error) {
+	won := 0
+	for _, teams := range [2][2]int{{a, b}, {b, a}} {
+		players := make([]ai.Player, gameCfg.NumPlayers)
+		for i := range players {
+			p, err := seats.player(teams[engine.Team(i)], i)
+			if err != nil {
+				return won, err
+			}
+			players[i] = p
+		}
+		gameCfg.Seed = seed
+		game := engine.NewGame(gameCfg)
+		if _, err := sim.PlayGame(game, players); err != nil {
+			return won, err
+		}
+		if w := game.Winner(); w >= 0 && teams[w] == a {
+			won++
+		}
+	}
+	return won, nil
+}
+
+// seating creates each entry's player for a seat on first use
+type seating struct {
+	entries []Entry
+	players map[[2]int]ai.Player // keyed by entry and seat
+}
+
+func newSeating(entries []Entry) *seating {
+	return &seating{entries: entries, players: make(map[[2]int]ai.Player)}
+}
+
+func (s *seating) player(entry, seat int) (ai.Player, error) {
+	key := [2]int{entry, seat}
+	if p, ok := s.players[key]; ok {
+		return p, nil
+	}
+	e := s.entries[entry]
+	p, err := ai.NewPlayer(e.Strategy, ai.PlayerNames[seat], seat, e.Difficulty)
+	if err != nil {
+		return nil, fmt.Errorf("entry %s: %w", e.Name, err)
+	}
+	s.players[key] = p
+	return p, nil
+}
+
+func (s *seating) close() {
+	players := make([]ai.Player, 0, len(s.players))
+	for _, p := range s.players {
+		players = append(players, p)
+	}
+	ai.ClosePlayers(players)
+}
@@ -0,0 +1,118 @@
+package tournament
+
+import (
+	"math"
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/ai"
+	_ "github.com/BrandonDedolph/euchre/internal/ai/rule_based" // registers the rule-based strategy
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
+
+func TestParseEntry(t *testing.T) {
+	tests := []struct {
+		spec string
+		want Entry
+	}{
+		{"rule-based", Entry{Name: "rule-based", Strategy: "rule-based", Difficulty: ai.DifficultyMedium}},
+		{"mcts:hard", Entry{Name: "mcts:hard", Strategy: "mcts", Difficulty: ai.DifficultyHard}},
+		{"base=rule-based:Easy", Entry{Name: "base", Strategy: "rule-based", Difficulty: ai.DifficultyEasy}},
+	}
+	for _, tt := range tests {
+		got, err := ParseEntry(tt.spec)
+		if err != nil {
+			t.Errorf("ParseEntry(%q) failed: %v", tt.spec, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseEntry(%q) = %+v, want %+v", tt.spec, got, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"", "=mcts", "name=", "mcts:impossible"} {
+		if _, err := ParseEntry(bad); err == nil {
+			t.Errorf("ParseEntry(%q) should fail", bad)
+		}
+	}
+}
+
+func TestRunPlaysEveryBoardInBothSeatings(t *testing.T) {
+	var entries []Entry
+	for _, spec := range []string{"rule-based:easy", "rule-based:medium", "rule-based:hard"} {
+		e, err := ParseEntry(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, e)
+	}
+
+	res, err := Run(Config{Entries: entries, Boards: 4, Seed: 9, Game: engine.DefaultGameConfig()})
+	if err != nil {
+		t.Fatalf("Run failed: %v", err)
+	}
+	if len(res.Pairings) != 3 {
+		t.Fatalf("got %d pairings, want 3 for 3 entries", len(res.Pairings))
+	}
+	for _, p := range res.Pairings {
+		if p.Games() != 8 {
+			t.Errorf("%s vs %s played %d games, want 8", entries[p.A].Name, entries[p.B].Name, p.Games())
+		}
+		if boards := p.Boards[0] + p.Boards[1] + p.Boards[2]; boards != 4 {
+			t.Errorf("%s vs %s recorded %d boards, want 4", entries[p.A].Name, entries[p.B].Name, boards)
+		}
+		if p.Wins[0] != p.Boards[1]+2*p.Boards[2] {
+			t.Errorf("wins %v don't match boards %v", p.Wins, p.Boards)
+		}
+	}
+
+	again, err := Run(Config{Entries: entries, Boards: 4, Seed: 9, Game: engine.DefaultGameConfig()})
+	if err != nil {
+		t.Fatalf("second Run failed: %v", err)
+	}
+	for i := range res.Pairings {
+		if res.Pairings[i] != again.Pairings[i] {
+			t.Errorf("same seed gave pairing %+v then %+v", res.Pairings[i], again.Pairings[i])
+		}
+	}
+}
+
+func TestRatingsFollowWinRate(t *testing.T) {
+	// A wins three games in four: about 190 Elo better.
+	res := Results{
+		Seed:     1,
+		Entries:  []Entry{{Name: "A"}, {Name: "B"}},
+		Pairings: []Pairing{{A: 0, B: 1, Wins: [2]int{150, 50}, Boards: [3]int{0, 50, 50}}},
+	}
+	ratings := res.Ratings()
+	if ratings[0].Entry != 0 {
+		t.Fatalf("best entry is %d, want A", ratings[0].Entry)
+	}
+	diff := ratings[0].Elo - ratings[1].Elo
+	if diff < 180 || diff > 195 {
+		t.Errorf("Elo difference = %.1f, want about %.1f", diff, 400*math.Log10(3))
+	}
+	if mean := (ratings[0].Elo + ratings[1].Elo) / 2; math.Abs(mean-baseElo) > 1e-6 {
+		t.Errorf("mean Elo = %.3f, want %d", mean, baseElo)
+	}
+	for _, r := range ratings {
+		if !(r.Low < r.Elo && r.Elo < r.High) {
+			t.Errorf("entry %d: Elo %.1f outside its interval [%.1f, %.1f]", r.Entry, r.Elo, r.Low, r.High)
+		}
+	}
+	if ratings[0].WinRate() != 0.75 {
+		t.Errorf("A's win rate = %v, want 0.75", ratings[0].WinRate())
+	}
+}
+
+func TestRatingsOfASweepAreFinite(t *testing.T) {
+	res := Results{
+		Seed:     1,
+		Entries:  []Entry{{Name: "A"}, {Name: "B"}},
+		Pairings: []Pairing{{A: 0, B: 1, Wins: [2]int{20, 0}, Boards: [3]int{0, 0, 10}}},
+	}
+	for _, r := range res.Ratings() {
+		if math.IsInf(r.Elo, 0) || math.IsNaN(r.Elo) || math.IsInf(r.High, 0) || math.IsInf(r.Low, 0) {
+			t.Errorf("entry %d rating is not finite: %+v", r.Entry, r)
+		}
+	}
+}
@@ -38,7 +38,8 @@ type CardView struct {
 	FaceUp  bool
 	Compact bool
 	// Trump, when set (not NoSuit), lets the card flag itself as the left bower
-	// — the off-suit jack that actually plays as trump — with a small trump pip.
+	// — the off-suit jack that actually plays as trump — or the Benny with a
+	// small trump pip.
 	Trump engine.Suit
 	// AccentColor tints the border for CardStyleTrickWinner (the winning team's
 	// color). Ignored by other styles.
@@ -71,6 +72,10 @@ func (c *CardView) Render() string {
 func (c *CardView) renderFull() string {
 	rank := c.Card.Rank.String()
 	suit := c.Card.Suit.Symbol()
+	if c.Card.IsJoker() {
+		// The Benny has no suit: a star in the middle, JK in the corners.
+		rank, suit = "JK", "★"
+	}
 
 	// Pad rank for alignment
 	rankPad := rank
@@ -113,9 +118,10 @@ func (c *CardView) renderFull() string {
 		// exactly 5 cells (rank=2 + space=1 + crown=2).
 		crown := lipgloss.NewStyle().Background(interiorBg).Render("👑")
 		interior1 = interiorStyle.Render(rankPad+" ") + crown
-	case c.Trump != engine.NoSuit && c.Card.IsLeftBower(c.Trump):
-		// Left bower (the off-suit jack that plays as trump): tuck a small trump
-		// pip into the top-right corner so a learner sees it counts as trump.
+	case c.Trump != engine.NoSuit && (c.Card.IsLeftBower(c.Trump) || c.Card.IsJoker()):
+		// Left bower (the off-suit jack that plays as trump) or the Benny: tuck
+		// a small trump pip into the top-right corner so a learner sees it
+		// counts as trump.
 		interior1 = interiorStyle.Render(rankPad + "  " + c.Trump.Symbol())
 	default:
 		interior1 = interiorStyle.Render(rankPad + "   ")
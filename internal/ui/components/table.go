@@ -37,12 +37,28 @@ type TableView struct {
 	CurrentPlayer  int
 	PlayerNames    []string
 	TricksWon      []int
-	Maker          int       // Player who called trump (-1 if none)
-	MakerAlone     bool      // Whether maker is going alone
-	TurnPulseFrame int       // Animation frame for turn indicator pulse
-	RoundNumber    int       // Current round number (1-based)
-	PlayerActions  [4]string // Latest per-seat action label (e.g. "passes"); "" = none
-	TrickWinner    int       // Seat of the just-won trick's card to crown; -1 = none
+	Maker          int      // Player who called trump (-1 if none)
+	MakerAlone     bool     // Whether maker is going alone
+	TurnPulseFrame int      // Animation frame for turn indicator pulse
+	RoundNumber    int      // Current round number (1-based)
+	PlayerActions  []string // Latest per-seat action label (e.g. "passes"); "" = none
+	TrickWinner    int      // Seat of the just-won trick's card to crown; -1 = none
+
+	// Positions is the seat drawn at each table position, in the order of
+	// the Position constants; -1 leaves a position empty. Teams is how many
+	// teams the seats are split into (seat p plays for team p % Teams).
+	Positions [6]int
+	Teams     int
+
+	// Stacks holds the face-up stack cards each seat has on the table, each
+	// covering a face-down card (two-handed Euchre). Indexed by seat; they are
+	// also counted in PlayerHands. Only the top seat draws them.
+	Stacks [][]engine.Card
+
+	// FaceUpHands, when set, shows each seat's actual cards instead of card
+	// backs (used by the replay viewer). Indexed by seat; the bottom seat's
+	// hand is drawn by the caller, as in normal play.
+	FaceUpHands [][]engine.Card
 
 	// Animation states
 	CardPlayAnim     *CardPlayAnim     // Card being played animation
@@ -51,23 +67,64 @@ type TableView struct {
 	CardFlipTotal    int               // Total frames for card flip animation
 }
 
-// NewTableView creates a new table view
+// Table positions, in the order of TableView.Positions. The upper side
+// positions are only filled at six seats, where Left and Right are the lower
+// ones.
+const (
+	PositionBottom = iota
+	PositionLeft
+	PositionTop
+	PositionRight
+	PositionUpperLeft
+	PositionUpperRight
+)
+
+// NewTableView creates a new table view for four players in two partnerships
 func NewTableView() *TableView {
-	return &TableView{
-		Width:       60,
-		Height:      20,
-		PlayerNames: []string{"You", "West", "Partner", "East"},
-		PlayerHands: []int{5, 5, 5, 5},
-		TricksWon:   []int{0, 0, 0, 0},
-		Maker:       -1,
-		TrickWinner: -1,
+	return NewTableViewFor(4, 2)
+}
+
+// NewTableViewFor creates a table view for numPlayers seats split into
+// numTeams teams. Three players sit You, West and East with the top of the
+// table empty; two sit face to face; six sit two to a side with one across.
+func NewTableViewFor(numPlayers, numTeams int) *TableView {
+	t := &TableView{
+		Width:         60,
+		Height:        20,
+		PlayerNames:   []string{"You", "West", "Partner", "East"},
+		PlayerHands:   make([]int, numPlayers),
+		TricksWon:     make([]int, numPlayers),
+		PlayerActions: make([]string, numPlayers),
+		Maker:         -1,
+		TrickWinner:   -1,
+		Positions:     [6]int{0, 1, 2, 3, -1, -1},
+		Teams:         numTeams,
+	}
+	switch numPlayers {
+	case 2:
+		t.PlayerNames = []string{"You", "Opponent"}
+		t.Positions = [6]int{0, -1, 1, -1, -1, -1}
+	case 3:
+		t.PlayerNames = []string{"You", "West", "East"}
+		t.Positions = [6]int{0, 1, -1, 2, -1, -1}
+	case 6:
+		t.PlayerNames = []string{"You", "West", "Far West", "North", "Far East", "East"}
+		if engine.TeamOf(3, numTeams) == engine.TeamOf(0, numTeams) {
+			t.PlayerNames[3] = "Partner"
+		}
+		t.Positions = [6]int{0, 1, 3, 5, 2, 4}
+	}
+	for i := range t.PlayerHands {
+		t.PlayerHands[i] = 5
 	}
+	return t
 }
 
 // teamAccent returns the accent color for a seat's team: green for your team
-// (seats 0,2) and red for the opponents (seats 1,3).
-func teamAccent(seat int) lipgloss.TerminalColor {
-	if engine.Team(seat) == engine.Team(0) {
+// and red for the opponents.
+func (t *TableView) teamAccent(seat int) lipgloss.TerminalColor {
+	teams := max(t.Teams, 1)
+	if engine.TeamOf(seat, teams) == engine.TeamOf(0, teams) {
 		return theme.ColGreen
 	}
 	return theme.ColRed
@@ -77,7 +134,7 @@ func teamAccent(seat int) lipgloss.TerminalColor {
 func (t *TableView) Render() string {
 	var sb strings.Builder
 
-	// Top player (partner, position 2)
+	// Top player (partner, at four seats)
 	sb.WriteString(t.renderTopPlayer())
 	sb.WriteString("\n")
 
@@ -100,17 +157,23 @@ func RenderTricksTable(tricks int) string {
 
 // renderTopPlayer renders the top player area
 func (t *TableView) renderTopPlayer() string {
-	name := t.PlayerNames[2]
-	cards := t.PlayerHands[2]
-	tricks := t.TricksWon[2]
+	seat := t.Positions[PositionTop]
+	// Fixed height to prevent layout shift (header + action + 5-card block).
+	block := lipgloss.NewStyle().Height(8)
+	if seat < 0 {
+		return block.Render("")
+	}
+	name := t.PlayerNames[seat]
+	cards := t.PlayerHands[seat]
+	tricks := t.TricksWon[seat]
 
 	indicator := ""
-	if t.CurrentPlayer == 2 {
+	if t.CurrentPlayer == seat {
 		indicator = t.renderTurnIndicator()
 	}
 
 	dealerBadge := ""
-	if t.Dealer == 2 {
+	if t.Dealer == seat {
 		dealerBadge = " " + theme.Current.DealerBadge.Render("DEALER")
 	}
 
@@ -122,23 +185,76 @@ func (t *TableView) renderTopPlayer() string {
 
 	// Reserved action line directly under the name (always present, blank when
 	// no action, so the seat's fixed height never changes).
-	actionLine := lipgloss.PlaceHorizontal(t.Width, lipgloss.Center, renderActionLabel(t.PlayerActions[2], t.Width))
+	actionLine := lipgloss.PlaceHorizontal(t.Width, lipgloss.Center, renderActionLabel(t.action(seat), t.Width))
 
-	// Show face-down cards (always show space for 5 cards even if fewer)
+	// Show face-down cards (always show space for 5 cards even if fewer),
+	// then the face-up stack cards on the table
 	cardDisplay := RenderFaceDown(min(cards, 5))
+	if stacks := t.stacks(seat); len(stacks) > 0 {
+		cardDisplay = lipgloss.JoinHorizontal(lipgloss.Top,
+			RenderFaceDown(min(cards-len(stacks), 6)), "  ", t.renderFaceUpRow(stacks))
+	}
+	if t.FaceUpHands != nil {
+		cardDisplay = t.renderFaceUpRow(t.FaceUpHands[seat])
+	}
 	cardDisplay = lipgloss.PlaceHorizontal(t.Width, lipgloss.Center, cardDisplay)
 
 	content := header + "\n" + actionLine + "\n" + cardDisplay
+	return block.Render(content)
+}
 
-	// Fixed height to prevent layout shift (header + action + 5-card block).
-	return lipgloss.NewStyle().Height(8).Render(content)
+// stacks returns a seat's face-up stack cards, nil if it has none
+func (t *TableView) stacks(seat int) []engine.Card {
+	if seat < 0 || seat >= len(t.Stacks) {
+		return nil
+	}
+	return t.Stacks[seat]
+}
+
+// action returns a seat's latest action label, "" if it has none
+func (t *TableView) action(seat int) string {
+	if seat < 0 || seat >= len(t.PlayerActions) {
+		return ""
+	}
+	return t.PlayerActions[seat]
+}
+
+// renderFaceUpRow renders a hand as a row of full-size face-up cards.
+func (t *TableView) renderFaceUpRow(cards []engine.Card) string {
+	if len(cards) == 0 {
+		return ""
+	}
+	views := make([]string, len(cards))
+	for i, c := range cards {
+		cv := NewCardView(c)
+		cv.Trump = t.Trump
+		views[i] = cv.Render()
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, views...)
+}
+
+// renderFaceUpColumn renders a hand as a column of compact face-up cards,
+// which fits the narrow side seats.
+func (t *TableView) renderFaceUpColumn(cards []engine.Card) string {
+	lines := make([]string, len(cards))
+	for i, c := range cards {
+		cv := NewCardView(c)
+		cv.Compact = true
+		cv.Trump = t.Trump
+		lines[i] = cv.Render()
+	}
+	return strings.Join(lines, "\n")
 }
 
 // renderMiddle renders the middle section with left player, trick, right player
 func (t *TableView) renderMiddle() string {
-	leftPlayer := t.renderSidePlayer(1, true) // West
+	leftPlayer := t.renderSidePlayer(t.Positions[PositionLeft], true) // West
 	trickArea := t.renderTrickArea()
-	rightPlayer := t.renderSidePlayer(3, false) // East
+	rightPlayer := t.renderSidePlayer(t.Positions[PositionRight], false) // East
+	if t.sixSeats() {
+		leftPlayer = t.renderSidePair(t.Positions[PositionUpperLeft], t.Positions[PositionLeft], true)
+		rightPlayer = t.renderSidePair(t.Positions[PositionUpperRight], t.Positions[PositionRight], false)
+	}
 
 	return lipgloss.JoinHorizontal(
 		lipgloss.Center,
@@ -152,6 +268,11 @@ func (t *TableView) renderMiddle() string {
 
 // renderSidePlayer renders a side player (East or West)
 func (t *TableView) renderSidePlayer(playerIdx int, isLeft bool) string {
+	// Fixed width and height to prevent layout shift
+	style := lipgloss.NewStyle().Width(14).Height(13)
+	if playerIdx < 0 {
+		return style.Render("")
+	}
 	name := t.PlayerNames[playerIdx]
 	cards := t.PlayerHands[playerIdx]
 	tricks := t.TricksWon[playerIdx]
@@ -168,6 +289,9 @@ func (t *TableView) renderSidePlayer(playerIdx int, isLeft bool) string {
 
 	// Render vertical face-down cards (West is reversed)
 	cardDisplay := RenderFaceDownVertical(min(cards, 5), isLeft)
+	if t.FaceUpHands != nil {
+		cardDisplay = t.renderFaceUpColumn(t.FaceUpHands[playerIdx])
+	}
 
 	// Build the side player display
 	var sb strings.Builder
@@ -185,12 +309,10 @@ func (t *TableView) renderSidePlayer(playerIdx int, isLeft bool) string {
 	// Reserved action line directly under the name (always present, blank when
 	// no action, so the seat's fixed height never changes). Width 14 matches the
 	// seat box; truncate so a long label can't widen the seat.
-	sb.WriteString(renderActionLabel(t.PlayerActions[playerIdx], 14))
+	sb.WriteString(renderActionLabel(t.action(playerIdx), 14))
 	sb.WriteString("\n")
 	sb.WriteString(cardDisplay)
 
-	// Fixed width and height to prevent layout shift
-	style := lipgloss.NewStyle().Width(14).Height(13)
 	if isLeft {
 		style = style.Align(lipgloss.Right)
 	} else {
@@ -200,6 +322,57 @@ func (t *TableView) renderSidePlayer(playerIdx int, isLeft bool) string {
 	return style.Render(sb.String())
 }
 
+// sixSeats reports whether the sides of the table seat two players each
+func (t *TableView) sixSeats() bool {
+	return t.Positions[PositionUpperLeft] >= 0 || t.Positions[PositionUpperRight] >= 0
+}
+
+// renderSidePair renders the two players sharing a side of a six-seat table
+// in the same box as a single side player: the upper seat at the top and
+// the lower at the bottom, each in compact form.
+func (t *TableView) renderSidePair(upper, lower int, isLeft bool) string {
+	align := lipgloss.Left
+	if isLeft {
+		align = lipgloss.Right
+	}
+	top := lipgloss.PlaceVertical(6, lipgloss.Top, t.renderCompactSeat(upper, align))
+	bottom := lipgloss.PlaceVertical(7, lipgloss.Bottom, t.renderCompactSeat(lower, align))
+	return lipgloss.NewStyle().Width(14).Height(13).Align(align).Render(top + "\n" + bottom)
+}
+
+// renderCompactSeat renders a side seat of a six-seat table: the dealer
+// badge, name, action and a one-line strip of card backs, or the cards
+// themselves when FaceUpHands is set.
+func (t *TableView) renderCompactSeat(seat int, align lipgloss.Position) string {
+	if seat < 0 {
+		return ""
+	}
+	indicator := ""
+	if t.CurrentPlayer == seat {
+		indicator = t.renderTurnIndicator()
+	}
+	badge := " "
+	if t.Dealer == seat {
+		badge = theme.Current.DealerBadge.Render("DEALER")
+	}
+	header := fmt.Sprintf("%s%s %s", t.PlayerNames[seat], indicator,
+		theme.Current.Muted.Render(fmt.Sprintf("(%d)", t.TricksWon[seat])))
+
+	cards := theme.Current.CardPattern.Render(strings.TrimSpace(strings.Repeat("▒ ", min(t.PlayerHands[seat], 5))))
+	if t.FaceUpHands != nil {
+		short := make([]string, len(t.FaceUpHands[seat]))
+		for i, c := range t.FaceUpHands[seat] {
+			cv := NewCardView(c)
+			cv.Compact = true
+			cv.Trump = t.Trump
+			short[i] = cv.Render()
+		}
+		cards = lipgloss.NewStyle().Width(14).Align(align).Render(strings.Join(short, " "))
+	}
+
+	return strings.Join([]string{badge, header, renderActionLabel(t.action(seat), 14), cards}, "\n")
+}
+
 // renderTrickArea renders the center area with played cards
 func (t *TableView) renderTrickArea() string {
 	cardWidth := 7                // Card width
@@ -277,6 +450,9 @@ func (t *TableView) renderTrickArea() string {
 	}
 
 	renderCard := func(playerIdx int) string {
+		if playerIdx < 0 {
+			return emptySlot()
+		}
 		// Check if this card is being animated in
 		if t.CardPlayAnim != nil && t.CardPlayAnim.FromPlayer == playerIdx {
 			cv := NewCardView(t.CardPlayAnim.Card)
@@ -295,7 +471,7 @@ func (t *TableView) renderTrickArea() string {
 					cv := NewCardView(pc.Card)
 					cv.Trump = t.Trump
 					cv.Style = CardStyleTrickWinner
-					cv.AccentColor = teamAccent(playerIdx)
+					cv.AccentColor = t.teamAccent(playerIdx)
 					return cv.Render()
 				}
 				// Loser: fade then blank.
@@ -312,12 +488,12 @@ func (t *TableView) renderTrickArea() string {
 				// fixed trick box — convergence is conveyed by the lean + fade.
 				card := cv.Render()
 				slot := lipgloss.NewStyle().Width(cardWidth).MaxWidth(cardWidth)
-				// You(0)/Partner(2) are centered already; West(1) leans right
-				// toward center, East(3) leans left toward center.
+				// You/Partner are centered already; West leans right toward
+				// center, East leans left toward center.
 				switch playerIdx {
-				case 1: // West: lean right (toward center)
+				case t.Positions[PositionLeft], t.Positions[PositionUpperLeft]: // West: lean right (toward center)
 					return slot.Align(lipgloss.Right).Render(card)
-				case 3: // East: lean left (toward center)
+				case t.Positions[PositionRight], t.Positions[PositionUpperRight]: // East: lean left (toward center)
 					return slot.Align(lipgloss.Left).Render(card)
 				default:
 					return slot.Align(lipgloss.Center).Render(card)
@@ -333,7 +509,7 @@ func (t *TableView) renderTrickArea() string {
 					cv := NewCardView(pc.Card)
 					cv.Trump = t.Trump
 					cv.Style = CardStyleTrickWinner
-					cv.AccentColor = teamAccent(playerIdx)
+					cv.AccentColor = t.teamAccent(playerIdx)
 					return cv.Render()
 				}
 			}
@@ -348,10 +524,10 @@ func (t *TableView) renderTrickArea() string {
 		return emptySlot()
 	}
 
-	topCard := renderCard(2)    // Partner
-	leftCard := renderCard(1)   // West
-	rightCard := renderCard(3)  // East
-	bottomCard := renderCard(0) // You
+	topCard := renderCard(t.Positions[PositionTop])       // Partner
+	leftCard := renderCard(t.Positions[PositionLeft])     // West
+	rightCard := renderCard(t.Positions[PositionRight])   // East
+	bottomCard := renderCard(t.Positions[PositionBottom]) // You
 
 	// Build layout:
 	//        [Partner]
@@ -362,6 +538,19 @@ func (t *TableView) renderTrickArea() string {
 	topRow := lipgloss.NewStyle().Height(cardHeight).Render(
 		lipgloss.PlaceHorizontal(totalWidth, lipgloss.Center, topCard),
 	)
+	// At six seats the upper side players flank the top card:
+	//   [Far West] [North] [Far East]
+	if t.sixSeats() {
+		topRow = lipgloss.NewStyle().Height(cardHeight).Render(
+			lipgloss.JoinHorizontal(lipgloss.Center,
+				renderCard(t.Positions[PositionUpperLeft]),
+				"  ",
+				topCard,
+				"  ",
+				renderCard(t.Positions[PositionUpperRight]),
+			),
+		)
+	}
 
 	// Middle row (West and East cards on sides)
 	middleRow := lipgloss.NewStyle().Height(cardHeight).Render(
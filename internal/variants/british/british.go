@@ -0,0 +1,62 @@
+package british
+
+import (
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/variants"
+	"github.com/BrandonDedolph/euchre/internal/variants/standard"
+)
+
+// British implements British Euchre: the standard 4-player game with a joker,
+// the Benny, added to the deck as the highest trump. A turned-up Benny can't
+// be ordered up, so the dealer names trump and takes it.
+type British struct {
+	*standard.Standard
+}
+
+// New creates a new British Euchre variant
+func New() *British {
+	return &British{Standard: standard.New()}
+}
+
+// Name returns the variant name
+func (b *British) Name() string {
+	return "British"
+}
+
+// Description returns a description of the variant
+func (b *British) Description() string {
+	return "4-player Euchre with a 25-card deck including the Benny (joker), the highest trump. If the Benny is turned up, the dealer names trump."
+}
+
+// CreateDeck creates the 25-card deck with the Benny
+func (b *British) CreateDeck() *engine.Deck {
+	return engine.NewBritishDeck()
+}
+
+// HasJoker returns whether this variant uses a joker
+func (b *British) HasJoker() bool {
+	return true
+}
+
+// TrumpHierarchy returns the trump cards in order from highest to lowest:
+// the Benny, then the standard trump order
+func (b *British) TrumpHierarchy(trump engine.Suit) []engine.Card {
+	benny := engine.NewCard(engine.NoSuit, engine.Joker)
+	return append([]engine.Card{benny}, b.Standard.TrumpHierarchy(trump)...)
+}
+
+// Options returns all configurable options. The Benny is added to the
+// 24-card deck, so there is no playing with 7s and 8s.
+func (b *British) Options() []variants.RuleOption {
+	var options []variants.RuleOption
+	for _, o := range b.Standard.Options() {
+		if o.Key != "sevens_and_eights" {
+			options = append(options, o)
+		}
+	}
+	return options
+}
+
+func init() {
+	variants.RegisterNew(func() variants.Variant { return New() })
+}
@@ -0,0 +1,47 @@
+package british
+
+import (
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/variants"
+)
+
+// TestBennyTopsTheTrumpHierarchy verifies the joker ranks above the right
+// bower and the deck has 25 cards.
+func TestBennyTopsTheTrumpHierarchy(t *testing.T) {
+	b := New()
+	order := b.TrumpHierarchy(engine.Hearts)
+	if !order[0].IsJoker() || !order[1].IsRightBower(engine.Hearts) {
+		t.Fatalf("trump order should start Benny, right bower; got %v", order[:2])
+	}
+	for i := 1; i < len(order); i++ {
+		if order[i-1].TrumpValue(engine.Hearts) <= order[i].TrumpValue(engine.Hearts) {
+			t.Errorf("%s should outrank %s", order[i-1], order[i])
+		}
+	}
+	if got := b.CreateDeck().Size(); got != 25 {
+		t.Errorf("deck has %d cards, want 25", got)
+	}
+}
+
+// TestRegisteredWithAFreshInstancePerGame verifies the variant is in the
+// default registry and that configuring one game's copy leaves others alone.
+func TestRegisteredWithAFreshInstancePerGame(t *testing.T) {
+	v, ok := variants.New("British")
+	if !ok {
+		t.Fatal("British variant is not registered")
+	}
+	_ = v.SetOption("stick_the_dealer", true)
+	other, _ := variants.New("British")
+	if other.HasStickTheDealer() {
+		t.Error("options set on one instance leaked into another")
+	}
+	config := variants.EngineConfig(v)
+	if _, ok := config.DeckConfig.(engine.BritishDeckConfig); !ok {
+		t.Errorf("deck config = %T, want BritishDeckConfig", config.DeckConfig)
+	}
+	if !config.Rules.StickTheDealer {
+		t.Error("engine rules lost the stick-the-dealer option")
+	}
+}
@@ -0,0 +1,77 @@
+package cutthroat
+
+import (
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/variants"
+	"github.com/BrandonDedolph/euchre/internal/variants/standard"
+)
+
+// Cutthroat implements three-handed Euchre: every player scores for
+// themselves, and whoever makes trump plays against the other two. With no
+// partner to sit out there is no going alone, and a euchre pays both
+// opponents.
+type Cutthroat struct {
+	*standard.Standard
+}
+
+// New creates a new Cutthroat Euchre variant
+func New() *Cutthroat {
+	return &Cutthroat{Standard: standard.New()}
+}
+
+// Name returns the variant name
+func (c *Cutthroat) Name() string {
+	return "Cutthroat"
+}
+
+// Description returns a description of the variant
+func (c *Cutthroat) Description() string {
+	return "3-player Euchre where everyone plays for themselves. The maker takes on the other two; a euchre scores 2 points for each of them. First to 10 points wins."
+}
+
+// PlayerCount returns the number of players
+func (c *Cutthroat) PlayerCount() int {
+	return 3
+}
+
+// TeamCount returns the number of teams: one per player
+func (c *Cutthroat) TeamCount() int {
+	return 3
+}
+
+// CanGoAlone returns whether players can go alone. The maker is already on
+// their own.
+func (c *Cutthroat) CanGoAlone() bool {
+	return false
+}
+
+// ScoreRound calculates the score for a completed round
+func (c *Cutthroat) ScoreRound(result engine.RoundResult) engine.ScoreUpdate {
+	var deltas [3]int
+	if result.WasEuchred {
+		for player := range deltas {
+			if player != result.Makers {
+				deltas[player] = result.DefendPoints
+			}
+		}
+	} else {
+		deltas[result.Makers] = result.MakerPoints
+	}
+	return engine.ScoreUpdate{Team0Delta: deltas[0], Team1Delta: deltas[1], Team2Delta: deltas[2]}
+}
+
+// Options returns all configurable options. Defending alone needs a partner
+// to sit out, so only stick the dealer carries over.
+func (c *Cutthroat) Options() []variants.RuleOption {
+	var options []variants.RuleOption
+	for _, o := range c.Standard.Options() {
+		if o.Key != "defend_alone" {
+			options = append(options, o)
+		}
+	}
+	return options
+}
+
+func init() {
+	variants.RegisterNew(func() variants.Variant { return New() })
+}
@@ -0,0 +1,41 @@
+package cutthroat
+
+import (
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/variants"
+)
+
+// TestEuchrePaysBothOpponents verifies the maker plays one against two: a
+// euchre scores for each opponent, a make only for the maker.
+func TestEuchrePaysBothOpponents(t *testing.T) {
+	c := New()
+	got := c.ScoreRound(engine.RoundResult{Makers: 1, WasEuchred: true, DefendPoints: 2})
+	if got != (engine.ScoreUpdate{Team0Delta: 2, Team2Delta: 2}) {
+		t.Errorf("euchre of player 1 scored %+v, want 2 each for players 0 and 2", got)
+	}
+	got = c.ScoreRound(engine.RoundResult{Makers: 2, MakerTricks: 5, MakerPoints: 2})
+	if got != (engine.ScoreUpdate{Team2Delta: 2}) {
+		t.Errorf("march by player 2 scored %+v, want 2 for player 2 only", got)
+	}
+}
+
+// TestEngineConfigSeatsThreeTeams verifies the registered variant builds a
+// three-player game in which everyone is their own team.
+func TestEngineConfigSeatsThreeTeams(t *testing.T) {
+	v, ok := variants.New("Cutthroat")
+	if !ok {
+		t.Fatal("Cutthroat variant is not registered")
+	}
+	game := engine.NewGame(variants.EngineConfig(v))
+	if game.NumPlayers() != 3 || game.NumTeams() != 3 {
+		t.Fatalf("got %d players in %d teams, want 3 and 3", game.NumPlayers(), game.NumTeams())
+	}
+	game.StartRound()
+	for p := 0; p < 3; p++ {
+		if n := len(game.Hand(p)); n != 5 {
+			t.Errorf("player %d was dealt %d cards, want 5", p, n)
+		}
+	}
+}
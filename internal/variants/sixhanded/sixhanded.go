@@ -0,0 +1,110 @@
+package sixhanded
+
+import (
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/variants"
+	"github.com/BrandonDedolph/euchre/internal/variants/standard"
+)
+
+// SixHanded implements six-handed Euchre, dealt from a 36-card deck that
+// runs down to the sixes. The six players sit either in three partnerships
+// of two, partners facing each other, or in two teams of three, alternating
+// round the table. A player going alone takes the best card of each partner
+// and discards as many before play.
+type SixHanded struct {
+	*standard.Standard
+	teams int
+}
+
+// New creates six-handed Euchre for three partnerships of two
+func New() *SixHanded {
+	return &SixHanded{Standard: standard.New(), teams: 3}
+}
+
+// NewTeamsOfThree creates six-handed Euchre for two teams of three
+func NewTeamsOfThree() *SixHanded {
+	return &SixHanded{Standard: standard.New(), teams: 2}
+}
+
+// Name returns the variant name
+func (s *SixHanded) Name() string {
+	if s.teams == 2 {
+		return "Six-Handed 3v3"
+	}
+	return "Six-Handed"
+}
+
+// Description returns a description of the variant
+func (s *SixHanded) Description() string {
+	if s.teams == 2 {
+		return "6-player Euchre with a 36-card deck, in two teams of three sitting alternately. A player going alone takes each partner's best card and discards two. First team to 10 points wins."
+	}
+	return "6-player Euchre with a 36-card deck, in three partnerships with partners across the table. A player going alone swaps a card with their partner; a euchre scores for both other teams. First team to 10 points wins."
+}
+
+// PlayerCount returns the number of players
+func (s *SixHanded) PlayerCount() int {
+	return 6
+}
+
+// TeamCount returns the number of teams
+func (s *SixHanded) TeamCount() int {
+	return s.teams
+}
+
+// CreateDeck creates the 36-card deck, 6 through Ace
+func (s *SixHanded) CreateDeck() *engine.Deck {
+	return engine.NewDeck36()
+}
+
+// Pack names the 36-card deck to the engine
+func (s *SixHanded) Pack() engine.DeckConfig {
+	return engine.Deck36Config{}
+}
+
+// LonerExchange reports that a lone maker takes a card from each partner
+func (s *SixHanded) LonerExchange() bool {
+	return true
+}
+
+// TrumpHierarchy returns the trump cards in order from highest to lowest
+func (s *SixHanded) TrumpHierarchy(trump engine.Suit) []engine.Card {
+	return append(s.Standard.TrumpHierarchy(trump),
+		engine.Card{Suit: trump, Rank: engine.Eight},
+		engine.Card{Suit: trump, Rank: engine.Seven},
+		engine.Card{Suit: trump, Rank: engine.Six},
+	)
+}
+
+// ScoreRound calculates the score for a completed round. A euchre pays every
+// team but the makers.
+func (s *SixHanded) ScoreRound(result engine.RoundResult) engine.ScoreUpdate {
+	var deltas [3]int
+	if result.WasEuchred {
+		for team := 0; team < s.teams; team++ {
+			if team != result.Makers {
+				deltas[team] = result.DefendPoints
+			}
+		}
+	} else {
+		deltas[result.Makers] = result.MakerPoints
+	}
+	return engine.ScoreUpdate{Team0Delta: deltas[0], Team1Delta: deltas[1], Team2Delta: deltas[2]}
+}
+
+// Options returns all configurable options. The 36-card deck already holds
+// the 7s and 8s, so that option doesn't apply.
+func (s *SixHanded) Options() []variants.RuleOption {
+	var options []variants.RuleOption
+	for _, o := range s.Standard.Options() {
+		if o.Key != "sevens_and_eights" {
+			options = append(options, o)
+		}
+	}
+	return options
+}
+
+func init() {
+	variants.RegisterNew(func() variants.Variant { return New() })
+	variants.RegisterNew(func() variants.Variant { return NewTeamsOfThree() })
+}
@@ -0,0 +1,61 @@
+package sixhanded
+
+import (
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/variants"
+)
+
+// TestEuchrePaysEveryOtherTeam verifies a euchre among three partnerships
+// scores for both defending teams.
+func TestEuchrePaysEveryOtherTeam(t *testing.T) {
+	got := New().ScoreRound(engine.RoundResult{Makers: 1, WasEuchred: true, DefendPoints: 2})
+	if got != (engine.ScoreUpdate{Team0Delta: 2, Team2Delta: 2}) {
+		t.Errorf("euchre of team 1 scored %+v, want 2 each for teams 0 and 2", got)
+	}
+	got = NewTeamsOfThree().ScoreRound(engine.RoundResult{Makers: 0, WasEuchred: true, DefendPoints: 2})
+	if got != (engine.ScoreUpdate{Team1Delta: 2}) {
+		t.Errorf("euchre of team 0 of two scored %+v, want 2 for team 1", got)
+	}
+}
+
+// TestEngineConfigSeatsSix verifies both registered variants build a
+// six-player game dealt from the 36-card deck that survives a snapshot.
+func TestEngineConfigSeatsSix(t *testing.T) {
+	for name, teams := range map[string]int{"Six-Handed": 3, "Six-Handed 3v3": 2} {
+		v, ok := variants.New(name)
+		if !ok {
+			t.Fatalf("%s variant is not registered", name)
+		}
+		config := variants.EngineConfig(v)
+		if !config.Rules.LonerExchange {
+			t.Errorf("%s: lone makers don't exchange with their partners", name)
+		}
+		game := engine.NewGame(config)
+		if game.NumPlayers() != 6 || game.NumTeams() != teams {
+			t.Fatalf("%s: got %d players in %d teams, want 6 and %d", name, game.NumPlayers(), game.NumTeams(), teams)
+		}
+		game.StartRound()
+		if n := len(game.Round().Pack()); n != 36 {
+			t.Errorf("%s: dealt from %d cards, want 36", name, n)
+		}
+		for p := 0; p < 6; p++ {
+			if n := len(game.Hand(p)); n != 5 {
+				t.Errorf("%s: player %d was dealt %d cards, want 5", name, p, n)
+			}
+		}
+
+		snap, err := game.Snapshot()
+		if err != nil {
+			t.Fatalf("%s: snapshot: %v", name, err)
+		}
+		restored, err := engine.RestoreGame(snap)
+		if err != nil {
+			t.Fatalf("%s: restore: %v", name, err)
+		}
+		if _, ok := restored.DeckConfig().(engine.Deck36Config); !ok {
+			t.Errorf("%s: restored game deals from %T", name, restored.DeckConfig())
+		}
+	}
+}
@@ -19,6 +19,7 @@ func New() *Standard {
 	// Set default options
 	_ = s.SetOption("stick_the_dealer", false)
 	_ = s.SetOption("defend_alone", false)
+	_ = s.SetOption("sevens_and_eights", false)
 
 	return s
 }
@@ -53,11 +54,20 @@ func (s *Standard) TargetScore() int {
 	return 10
 }
 
-// CreateDeck creates a standard 24-card Euchre deck
+// CreateDeck creates a standard 24-card Euchre deck, or the 32-card deck
+// when playing with 7s and 8s
 func (s *Standard) CreateDeck() *engine.Deck {
+	if s.SevensAndEights() {
+		return engine.NewDeck32()
+	}
 	return engine.NewStandardDeck()
 }
 
+// SevensAndEights returns whether the deck runs down to the 7s
+func (s *Standard) SevensAndEights() bool {
+	return s.GetBoolOption("sevens_and_eights", false)
+}
+
 // HasJoker returns whether this variant uses a joker
 func (s *Standard) HasJoker() bool {
 	return false
@@ -78,7 +88,7 @@ func (s *Standard) TrumpHierarchy(trump engine.Suit) []engine.Card {
 		leftBowerSuit = engine.Spades
 	}
 
-	return []engine.Card{
+	hierarchy := []engine.Card{
 		{Suit: trump, Rank: engine.Jack},         // Right Bower
 		{Suit: leftBowerSuit, Rank: engine.Jack}, // Left Bower
 		{Suit: trump, Rank: engine.Ace},
@@ -87,6 +97,13 @@ func (s *Standard) TrumpHierarchy(trump engine.Suit) []engine.Card {
 		{Suit: trump, Rank: engine.Ten},
 		{Suit: trump, Rank: engine.Nine},
 	}
+	if s.SevensAndEights() {
+		hierarchy = append(hierarchy,
+			engine.Card{Suit: trump, Rank: engine.Eight},
+			engine.Card{Suit: trump, Rank: engine.Seven},
+		)
+	}
+	return hierarchy
 }
 
 // IsLeftBower checks if a card is the Left Bower
@@ -165,9 +182,16 @@ func (s *Standard) Options() []variants.RuleOption {
 			Type:        variants.OptionBool,
 			Default:     false,
 		},
+		{
+			Key:         "sevens_and_eights",
+			Name:        "Sevens and Eights",
+			Description: "Add the 7s and 8s for a 32-card deck",
+			Type:        variants.OptionBool,
+			Default:     false,
+		},
 	}
 }
 
 func init() {
-	variants.Register(New())
+	variants.RegisterNew(func() variants.Variant { return New() })
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/variants"
 )
 
 // TestScoreRound_DefendAloneEuchre verifies a defend-alone euchre awards 4
@@ -56,3 +57,46 @@ func TestScoreRound_MakersScore(t *testing.T) {
 		t.Errorf("makers should score their MakerPoints, got Team0Delta=%d", got.Team0Delta)
 	}
 }
+
+// TestSevensAndEights verifies the option deals from the 32-card deck, ranks
+// the 8 and 7 at the bottom of trump and survives a game snapshot.
+func TestSevensAndEights(t *testing.T) {
+	s := New()
+	if _, ok := variants.EngineDeck(s).(engine.StandardDeckConfig); !ok {
+		t.Fatalf("default deck = %T, want the standard deck", variants.EngineDeck(s))
+	}
+
+	_ = s.SetOption("sevens_and_eights", true)
+	if n := s.CreateDeck().Size(); n != 32 {
+		t.Errorf("deck has %d cards, want 32", n)
+	}
+	hierarchy := s.TrumpHierarchy(engine.Spades)
+	if n := len(hierarchy); n != 9 {
+		t.Fatalf("trump hierarchy has %d cards, want 9", n)
+	}
+	if low := hierarchy[7:]; low[0].Rank != engine.Eight || low[1].Rank != engine.Seven {
+		t.Errorf("lowest trumps = %v, want the 8 then the 7", low)
+	}
+
+	game := engine.NewGame(variants.EngineConfig(s))
+	game.StartRound()
+	if n := len(game.Round().Pack()); n != 32 {
+		t.Errorf("dealt from %d cards, want 32", n)
+	}
+	for p := 0; p < 4; p++ {
+		if n := len(game.Hand(p)); n != 5 {
+			t.Errorf("player %d was dealt %d cards, want 5", p, n)
+		}
+	}
+	snap, err := game.Snapshot()
+	if err != nil {
+		t.Fatalf("snapshot: %v", err)
+	}
+	restored, err := engine.RestoreGame(snap)
+	if err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	if _, ok := restored.DeckConfig().(engine.Deck32Config); !ok {
+		t.Errorf("restored game deals from %T, want the 32-card deck", restored.DeckConfig())
+	}
+}
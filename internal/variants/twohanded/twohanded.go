@@ -0,0 +1,72 @@
+package twohanded
+
+import (
+	"github.com/BrandonDedolph/euchre/internal/variants"
+	"github.com/BrandonDedolph/euchre/internal/variants/standard"
+)
+
+// stacks is how many face-down cards each player is dealt, each covered by
+// a face-up card. With a hand of five that deals 11 cards to each player,
+// and with the turned card leaves one in the kitty.
+const stacks = 3
+
+// TwoHanded implements two-handed Euchre with the standard 24-card deck.
+// Besides a hand of five, each player is dealt a row of face-down cards
+// with a face-up card on each, which they play as part of their hand; the
+// card beneath turns up when the one on top is played. The maker needs a
+// majority of the 11 tricks.
+type TwoHanded struct {
+	*standard.Standard
+}
+
+// New creates a new two-handed Euchre variant
+func New() *TwoHanded {
+	return &TwoHanded{Standard: standard.New()}
+}
+
+// Name returns the variant name
+func (t *TwoHanded) Name() string {
+	return "Two-Handed"
+}
+
+// Description returns a description of the variant
+func (t *TwoHanded) Description() string {
+	return "2-player Euchre. Each player also plays a row of three face-down cards, each under a face-up card that turns it over when played. Take 6 of the 11 tricks to score. First to 10 points wins."
+}
+
+// PlayerCount returns the number of players
+func (t *TwoHanded) PlayerCount() int {
+	return 2
+}
+
+// CardsPerHand returns cards dealt to each player: the hand and both rows
+func (t *TwoHanded) CardsPerHand() int {
+	return 5 + 2*stacks
+}
+
+// Stacks returns how many face-down, face-up pairs each player is dealt
+func (t *TwoHanded) Stacks() int {
+	return stacks
+}
+
+// CanGoAlone returns whether players can go alone. Each player is already
+// on their own.
+func (t *TwoHanded) CanGoAlone() bool {
+	return false
+}
+
+// Options returns all configurable options. Defending alone needs a partner
+// to sit out, so only stick the dealer carries over.
+func (t *TwoHanded) Options() []variants.RuleOption {
+	var options []variants.RuleOption
+	for _, o := range t.Standard.Options() {
+		if o.Key != "defend_alone" {
+			options = append(options, o)
+		}
+	}
+	return options
+}
+
+func init() {
+	variants.RegisterNew(func() variants.Variant { return New() })
+}
@@ -0,0 +1,35 @@
+package twohanded
+
+import (
+	"testing"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+	"github.com/BrandonDedolph/euchre/internal/variants"
+)
+
+// TestEngineConfigDealsStacks verifies the registered variant builds a
+// two-player game that deals each player a hand and a row of stacks.
+func TestEngineConfigDealsStacks(t *testing.T) {
+	v, ok := variants.New("Two-Handed")
+	if !ok {
+		t.Fatal("Two-Handed variant is not registered")
+	}
+	game := engine.NewGame(variants.EngineConfig(v))
+	if game.NumPlayers() != 2 || game.NumTeams() != 2 {
+		t.Fatalf("got %d players in %d teams, want 2 and 2", game.NumPlayers(), game.NumTeams())
+	}
+	game.StartRound()
+	for p := 0; p < 2; p++ {
+		if n := len(game.Round().Stacks(p)); n != stacks {
+			t.Errorf("player %d was dealt %d stacks, want %d", p, n, stacks)
+		}
+		if n := game.Round().CardsLeft(p); n != v.CardsPerHand() {
+			t.Errorf("player %d has %d cards to play, want %d", p, n, v.CardsPerHand())
+		}
+	}
+	for _, a := range game.LegalActions() {
+		if o, ok := a.(engine.OrderUpAction); ok && o.Alone {
+			t.Error("going alone is offered with no partner to sit out")
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package variants
 
-import "github.com/BrandonDedolph/euchre/internal/engine"
+import (
+	"sort"
+
+	"github.com/BrandonDedolph/euchre/internal/engine"
+)
 
 // Variant defines the rules and configuration for a Euchre variant
 type Variant interface {
@@ -40,6 +44,24 @@ type Variant interface {
 	GetBoolOption(key string, defaultVal bool) bool
 }
 
+// Stacker is implemented by variants that deal each player a row of
+// face-down cards, each covered by a face-up one (see engine.Rules.Stacks).
+type Stacker interface {
+	Stacks() int
+}
+
+// Packer is implemented by variants dealt from a pack other than the
+// standard or British one. EngineDeck uses it to name the pack to the engine.
+type Packer interface {
+	Pack() engine.DeckConfig
+}
+
+// Exchanger is implemented by variants whose lone makers take a card from
+// each partner before play (see engine.Rules.LonerExchange).
+type Exchanger interface {
+	LonerExchange() bool
+}
+
 // RuleOption represents a configurable rule setting
 type RuleOption struct {
 	Key         string
@@ -100,13 +122,15 @@ func (v *BaseVariant) GetIntOption(key string, defaultVal int) int {
 
 // Registry holds all registered variants
 type Registry struct {
-	variants map[string]Variant
+	variants     map[string]Variant
+	constructors map[string]func() Variant
 }
 
 // NewRegistry creates a new variant registry
 func NewRegistry() *Registry {
 	return &Registry{
-		variants: make(map[string]Variant),
+		variants:     make(map[string]Variant),
+		constructors: make(map[string]func() Variant),
 	}
 }
 
@@ -115,18 +139,38 @@ func (r *Registry) Register(v Variant) {
 	r.variants[v.Name()] = v
 }
 
+// RegisterNew adds the variant newVariant builds, keeping the constructor so
+// New can hand out fresh copies
+func (r *Registry) RegisterNew(newVariant func() Variant) {
+	v := newVariant()
+	r.Register(v)
+	r.constructors[v.Name()] = newVariant
+}
+
+// New returns a fresh instance of a variant, so setting its options doesn't
+// change the registered one. Variants added with Register have no
+// constructor and are not found.
+func (r *Registry) New(name string) (Variant, bool) {
+	newVariant, ok := r.constructors[name]
+	if !ok {
+		return nil, false
+	}
+	return newVariant(), true
+}
+
 // Get retrieves a variant by name
 func (r *Registry) Get(name string) (Variant, bool) {
 	v, ok := r.variants[name]
 	return v, ok
 }
 
-// List returns all registered variant names
+// List returns all registered variant names, sorted
 func (r *Registry) List() []string {
 	names := make([]string, 0, len(r.variants))
 	for name := range r.variants {
 		names = append(names, name)
 	}
+	sort.Strings(names)
 	return names
 }
 
@@ -147,12 +191,80 @@ func Register(v Variant) {
 	DefaultRegistry.Register(v)
 }
 
+// RegisterNew adds a variant and its constructor to the default registry
+func RegisterNew(newVariant func() Variant) {
+	DefaultRegistry.RegisterNew(newVariant)
+}
+
 // Get retrieves a variant from the default registry
 func Get(name string) (Variant, bool) {
 	return DefaultRegistry.Get(name)
 }
 
+// New returns a fresh instance of a variant from the default registry
+func New(name string) (Variant, bool) {
+	return DefaultRegistry.New(name)
+}
+
 // List returns all variant names from the default registry
 func List() []string {
 	return DefaultRegistry.List()
 }
+
+// Offers reports whether a variant lists the rule option key among its
+// Options. Callers use it to apply only the settings a variant supports.
+func Offers(v Variant, key string) bool {
+	for _, o := range v.Options() {
+		if o.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// EngineRules maps a variant's options onto the engine's plain Rules struct.
+// The engine cannot import variants (that would be a circular import), so
+// every caller that builds an engine.Game from a variant routes through here.
+// The variant stays the single source of truth for rule invariants such as
+// AllowMisdeal == !StickTheDealer.
+func EngineRules(v Variant) engine.Rules {
+	rules := engine.Rules{
+		StickTheDealer:   v.HasStickTheDealer(),
+		AllowMisdeal:     v.AllowMisdeal(),
+		AllowDefendAlone: v.GetBoolOption("defend_alone", false),
+		Teams:            v.TeamCount(),
+	}
+	if s, ok := v.(Stacker); ok {
+		rules.Stacks = s.Stacks()
+	}
+	if e, ok := v.(Exchanger); ok {
+		rules.LonerExchange = e.LonerExchange()
+	}
+	return rules
+}
+
+// EngineDeck returns the engine's deck configuration for a variant. Games
+// are built on the engine's named configurations rather than on
+// Variant.CreateDeck so they can be snapshotted and restored.
+func EngineDeck(v Variant) engine.DeckConfig {
+	if p, ok := v.(Packer); ok {
+		return p.Pack()
+	}
+	if v.HasJoker() {
+		return engine.BritishDeckConfig{}
+	}
+	if v.GetBoolOption("sevens_and_eights", false) {
+		return engine.Deck32Config{}
+	}
+	return engine.StandardDeckConfig{}
+}
+
+// EngineConfig returns the engine configuration for a game of the variant
+func EngineConfig(v Variant) engine.GameConfig {
+	config := engine.DefaultGameConfig()
+	config.NumPlayers = v.PlayerCount()
+	config.TargetScore = v.TargetScore()
+	config.DeckConfig = EngineDeck(v)
+	config.Rules = EngineRules(v)
+	return config
+}